@@ -2,142 +2,496 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
-	"path/filepath"
+	"os"
+	"os/signal"
+	"strings"
+	"sync/atomic"
+	"syscall"
 	"time"
 
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	"go-k8s-client/pkg/checks"
+	"go-k8s-client/pkg/client"
+	"go-k8s-client/pkg/leader"
+	"go-k8s-client/pkg/plugin"
+	"go-k8s-client/pkg/remediate"
+	"go-k8s-client/pkg/report"
+	"go-k8s-client/pkg/shard"
+	"go-k8s-client/pkg/state"
+	"go-k8s-client/pkg/watch"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/clientcmd"
-	"k8s.io/client-go/util/homedir"
 )
 
-// NoNodesInKubernetes, Kubernetes cluster'ında hiç node olmadığında döndürülür.
-type NoNodesInKubernetes struct{}
-
-func (err NoNodesInKubernetes) Error() string {
-	return "Kubernetes cluster'ında hiç node yok"
-}
-
-// PersistentVolumeClaimNotInStatus, bir PersistentVolumeClaim beklenen durumda olmadığında döndürülür.
-type PersistentVolumeClaimNotInStatus struct {
-	pvc            *corev1.PersistentVolumeClaim
-	pvcStatusPhase *corev1.PersistentVolumeClaimPhase
-}
-
-func (err PersistentVolumeClaimNotInStatus) Error() string {
-	return fmt.Sprintf("PersistentVolumeClaim %s beklenen %v durumunda değil", err.pvc.Name, *err.pvcStatusPhase)
-}
-
 func main() {
 	var kubeconfig *string
-	if home := homedir.HomeDir(); home != "" {
-		kubeconfig = flag.String("kubeconfig", filepath.Join(home, ".kube", "config"), "(isteğe bağlı) kubeconfig dosyasının mutlak yolu")
+	if home := client.DefaultKubeconfig(); home != "" {
+		kubeconfig = flag.String("kubeconfig", home, "(isteğe bağlı) kubeconfig dosyasının mutlak yolu")
 	} else {
 		kubeconfig = flag.String("kubeconfig", "", "kubeconfig dosyasının mutlak yolu")
 	}
+	pluginDir := flag.String("plugin-dir", "", "k8s-client-plugin-* binary'lerinin aranacağı dizin; boşsa plugin yüklenmez")
+	execChecksConfig := flag.String("exec-checks-config", "", "harici komut kontrollerini tanımlayan YAML/JSON dosyası")
+	resourceChecksConfig := flag.String("resource-checks-config", "", "group/version/resource ile tanımlanan genel sayı ve koşul kontrollerini içeren YAML/JSON dosyası")
+	userAgent := flag.String("user-agent", client.DefaultUserAgent, "API isteklerinde kullanılacak User-Agent; bu aracın istekleri audit log'larında ve API server metriklerinde bununla ayırt edilir")
+	flowSchema := flag.String("flow-schema", "", "bu aracın isteklerini --flow-schema-priority-level seviyesine yönlendiren API Priority and Fairness FlowSchema'sının adı; verilirse başlangıçta oluşturulur ya da güncellenir")
+	flowSchemaPriorityLevel := flag.String("flow-schema-priority-level", "workload-low", "--flow-schema'nın yönlendireceği mevcut PriorityLevelConfiguration")
+	flowSchemaPrecedence := flag.Int("flow-schema-precedence", 8000, "--flow-schema'nın matchingPrecedence değeri; yerleşik service-accounts FlowSchema'sından (9000) küçük olmalıdır")
+	flowSchemaServiceAccount := flag.String("flow-schema-service-account", "", "--flow-schema'nın eşleşeceği, bu aracın çalıştığı ServiceAccount (namespace/ad)")
+	useInformers := flag.Bool("informers", true, "sık listelenen resource'ları her döngüde List yerine paylaşılan informer cache'inden, Warning ve seçili event'leri süzülmüş watch'larla beslenen tampondan oku")
+	informerResync := flag.Duration("informer-resync", 10*time.Minute, "informer cache'lerinin yeniden senkronize edilme aralığı")
+	informerSyncTimeout := flag.Duration("informer-sync-timeout", 2*time.Minute, "informer cache'lerinin ilk dolumu için beklenecek süre")
+	checkTimeout := flag.Duration("check-timeout", time.Minute, "tek bir kontrolün API istekleri iptal edilmeden önce çalışabileceği en uzun süre; 0 sınırsız")
+	cycleTimeout := flag.Duration("cycle-timeout", 5*time.Minute, "bir kontrol döngüsünün tamamı için en uzun süre; 0 sınırsız")
+	cycleInterval := flag.Duration("cycle-interval", 10*time.Second, "kontrol döngüleri arasındaki bekleme süresi")
+	maxCycleInterval := flag.Duration("max-cycle-interval", 5*time.Minute, "API server istekleri sınırladığında döngü aralığının uzatılabileceği en fazla süre")
+	shutdownGrace := flag.Duration("shutdown-grace", 20*time.Second, "SIGINT/SIGTERM alındığında devam eden döngünün tamamlanması için beklenecek en uzun süre")
+	concurrency := flag.Int("concurrency", 4, "aynı anda çalıştırılacak en fazla kontrol sayısı")
+	leaderElect := flag.Bool("leader-elect", false, "birden fazla replica çalışırken kontrolleri yalnızca Lease'i alan instance'ın çalıştırması için leader election kullan")
+	leaderElectNamespace := flag.String("leader-elect-namespace", defaultNamespace(), "leader election Lease'inin namespace'i")
+	leaderElectLeaseName := flag.String("leader-elect-lease-name", "go-k8s-client", "leader election Lease'inin adı")
+	leaderElectIdentity := flag.String("leader-elect-identity", "", "bu instance'ın Lease'teki kimliği; boşsa hostname (pod adı) kullanılır")
+	leaderElectLeaseDuration := flag.Duration("leader-elect-lease-duration", 15*time.Second, "lider yenilemediğinde diğer replica'ların Lease'i devralmak için bekleyeceği süre")
+	leaderElectRenewDeadline := flag.Duration("leader-elect-renew-deadline", 10*time.Second, "liderin Lease'i yenileyemezse liderliği bırakacağı süre")
+	leaderElectRetryPeriod := flag.Duration("leader-elect-retry-period", 2*time.Second, "Lease alma ve yenileme denemeleri arasındaki süre")
+	remediateActions := flag.String("remediate", "", "uygulanmasına izin verilen düzeltme eylemleri: delete-evicted-pods, restart-crashlooping-deployments, retrigger-stuck-jobs")
+	remediateNamespaces := flag.String("remediate-namespaces", "", "düzeltme eylemlerinin uygulanabileceği namespace'ler; tümü için *")
+	remediateApply := flag.Bool("remediate-apply", false, "düzeltme eylemlerini gerçekten uygula; kapalıyken yalnızca server-side dry-run yapılır")
+	remediateMaxActions := flag.Int("remediate-max-actions", 10, "bir döngüde uygulanabilecek en fazla düzeltme eylemi")
+	remediateCrashLoopRestarts := flag.Int("remediate-crashloop-restarts", 5, "Deployment'a restart yapılması için CrashLoopBackOff container'ının en az yeniden başlatma sayısı")
+	remediateRestartCooldown := flag.Duration("remediate-restart-cooldown", 30*time.Minute, "aynı Deployment'a art arda restart yapılmadan önce beklenecek süre")
+	remediateJobStuckAfter := flag.Duration("remediate-job-stuck-after", 6*time.Hour, "tamamlanmayan Job'ların yeniden tetiklenmeden önce çalışabileceği süre")
+	remediateAuditLog := flag.String("remediate-audit-log", "remediation-audit.jsonl", "her düzeltme eyleminin JSON satırı olarak eklendiği denetim kaydı dosyası")
+	stateFile := flag.String("state-file", "", "bulgu durumlarının (ilk/son görülme, onay, çözülme) saklanacağı JSON dosyası")
+	stateConfigMap := flag.String("state-configmap", "", "bulgu durumlarının saklanacağı ConfigMap (namespace/ad); --state-file yerine cluster içinde kullanılır")
+	stateRetention := flag.Duration("state-retention", 7*24*time.Hour, "çözülen bulguların durum kaydında tutulacağı süre")
+	onlyNewFindings := flag.Bool("only-new-findings", false, "durum saklama açıkken yalnızca yeni ya da yeniden ortaya çıkan bulguları yaz")
+	shardResultMaxAge := flag.Duration("shard-result-max-age", 10*time.Minute, "--shards birden büyükken liderin diğer replica'ların sonuçlarını birleştirirken kabul edeceği en eski rapor yaşı")
+	watchMode := flag.Bool("watch", false, "periyodik kontroller yerine pod faz geçişlerini ve yeni Warning event'lerini gerçekleştikleri anda raporla")
+	options := checks.DefaultOptions()
+	options.AddFlags(flag.CommandLine)
 	flag.Parse()
 
-	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	shutdown, ctx := handleSignals(*shutdownGrace)
+
+	clients, err := client.New(*kubeconfig, *userAgent)
+	if err != nil {
+		panic(err.Error())
+	}
+	// Sharding açıkken her replica yalnızca kendi namespace'lerini kontrol
+	// edip sonuçlarını yayınlar; lider hepsini birleştirip yazar.
+	var exchange *shard.Exchange
+	if options.Shards > 1 {
+		if !*leaderElect {
+			panic("--shards için sonuçları birleştirecek lideri seçmek üzere --leader-elect gereklidir")
+		}
+		shardIndexSet := false
+		flag.Visit(func(f *flag.Flag) { shardIndexSet = shardIndexSet || f.Name == "shard-index" })
+		if !shardIndexSet {
+			hostname, _ := os.Hostname()
+			if options.ShardIndex, err = shard.OrdinalFromHostname(hostname); err != nil {
+				panic(err.Error())
+			}
+		}
+		if options.ShardIndex < 0 || options.ShardIndex >= options.Shards {
+			panic(fmt.Sprintf("--shard-index %d, 0 ile %d arasında olmalıdır", options.ShardIndex, options.Shards-1))
+		}
+		exchange = &shard.Exchange{Clientset: clients.Kubernetes, Namespace: *leaderElectNamespace, Prefix: *leaderElectLeaseName, Shards: options.Shards}
+	}
+	if *flowSchema != "" {
+		namespace, name, ok := strings.Cut(*flowSchemaServiceAccount, "/")
+		if !ok || namespace == "" || name == "" {
+			panic("--flow-schema için --flow-schema-service-account namespace/ad biçiminde verilmelidir")
+		}
+		err := client.EnsureFlowSchema(ctx, clients.Kubernetes, client.FlowSchemaConfig{
+			Name:                    *flowSchema,
+			PriorityLevel:           *flowSchemaPriorityLevel,
+			MatchingPrecedence:      int32(*flowSchemaPrecedence),
+			ServiceAccountNamespace: namespace,
+			ServiceAccountName:      name,
+		})
+		if err != nil {
+			fmt.Println(err)
+		}
+	}
+	if *watchMode {
+		fmt.Println("Pod ve event değişiklikleri izleniyor...")
+		err := watch.Run(shutdown, clients.Kubernetes, *informerResync, func(finding report.Finding) {
+			report.Print(os.Stdout, []report.Finding{finding})
+		})
+		if err != nil && shutdown.Err() == nil {
+			panic(err.Error())
+		}
+		return
+	}
+
+	suite := checks.NewSuite(options, clients.Dynamic, clients.Metadata, clients.Config)
+	if *useInformers {
+		if err := suite.StartInformers(ctx, clients.Kubernetes, *informerResync, *informerSyncTimeout); err != nil {
+			fmt.Println(err)
+		}
+		if err := suite.StartEventWatch(ctx, clients.Kubernetes, *informerSyncTimeout); err != nil {
+			fmt.Println(err)
+		}
+	}
+
+	var pluginChecks []checks.Check
+	if *pluginDir != "" {
+		plugins, errs := plugin.Discover(ctx, *pluginDir, *kubeconfig)
+		for _, err := range errs {
+			fmt.Println(err)
+		}
+		for _, p := range plugins {
+			defer p.Close()
+			pluginChecks = append(pluginChecks, p.Checks()...)
+		}
+		fmt.Printf("%d plugin'den %d kontrol yüklendi\n", len(plugins), len(pluginChecks))
+	}
+	if *execChecksConfig != "" {
+		execChecks, err := plugin.LoadExecChecks(*execChecksConfig, *kubeconfig)
+		if err != nil {
+			panic(err.Error())
+		}
+		pluginChecks = append(pluginChecks, execChecks...)
+	}
+	if *resourceChecksConfig != "" {
+		resourceChecks, err := suite.LoadResourceChecks(*resourceChecksConfig)
+		if err != nil {
+			panic(err.Error())
+		}
+		pluginChecks = append(pluginChecks, resourceChecks...)
+	}
+
+	cycleChecks, err := suite.Cached(append(suite.Checks(), pluginChecks...))
 	if err != nil {
 		panic(err.Error())
 	}
 
-	clientset, err := kubernetes.NewForConfig(config)
+	// runCycles, kontrolleri shutdown ya da runCtx iptal edilene kadar
+	// döngüler halinde çalıştırır. Leader election açıkken runCtx,
+	// liderlik kaybedildiğinde iptal edilir.
+	printer := resultPrinter{onlyNew: *onlyNewFindings}
+	var store state.Store
+	switch {
+	case *stateFile != "":
+		store = state.FileStore{Path: *stateFile}
+	case *stateConfigMap != "":
+		namespace, name, ok := strings.Cut(*stateConfigMap, "/")
+		if !ok {
+			namespace, name = defaultNamespace(), *stateConfigMap
+		}
+		store = state.ConfigMapStore{Clientset: clients.Kubernetes, Namespace: namespace, Name: name}
+	}
+	if store != nil {
+		if printer.tracker, err = state.NewTracker(ctx, store, *stateRetention); err != nil {
+			panic(err.Error())
+		}
+	}
+
+	finalChecks := []checks.Check{checks.SpecificPod("default", "alpine-deployment-548dbddc9b-dnq9r")}
+	actions, err := remediate.ParseActions(*remediateActions)
 	if err != nil {
 		panic(err.Error())
 	}
+	if len(actions) > 0 {
+		if strings.TrimSpace(*remediateNamespaces) == "" {
+			panic("--remediate-namespaces boş; düzeltme eylemlerinin uygulanacağı namespace'ler açıkça verilmelidir (tümü için *)")
+		}
+		audit, err := os.OpenFile(*remediateAuditLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			panic(err.Error())
+		}
+		defer audit.Close()
+		var namespaces []string
+		for _, namespace := range strings.Split(*remediateNamespaces, ",") {
+			if namespace = strings.TrimSpace(namespace); namespace != "" {
+				namespaces = append(namespaces, namespace)
+			}
+		}
+		// Düzeltme eylemleri namespace'lere göre bölünür; sharding açıkken her
+		// replica yalnızca kendi namespace'lerinde eylem uygular.
+		finalChecks = append(finalChecks, checks.ShardByNamespace(remediate.New(remediate.Config{
+			Actions:           actions,
+			Namespaces:        namespaces,
+			Apply:             *remediateApply,
+			MaxActions:        *remediateMaxActions,
+			CrashLoopRestarts: int32(*remediateCrashLoopRestarts),
+			RestartCooldown:   *remediateRestartCooldown,
+			JobStuckAfter:     *remediateJobStuckAfter,
+			Audit:             audit,
+			Options:           options,
+		})))
+	}
+	finalChecks = append(finalChecks, checks.APIThrottling(clients.Throttle, *flowSchema))
+	var leading atomic.Bool
+	runCycles := func(runCtx context.Context) {
+		interval := *cycleInterval
+		lastSkipped := ""
+		// Bulgu durumları her liderlik döneminin başında store'dan yeniden
+		// yüklenir; böylece önceki liderin kaydettikleri korunur.
+		trackerLoaded := false
+		for shutdown.Err() == nil && runCtx.Err() == nil {
+			throttledBefore := clients.Throttle.Stats()
+			cycleCtx, cancel := runCtx, context.CancelFunc(func() {})
+			if *cycleTimeout > 0 {
+				cycleCtx, cancel = context.WithTimeout(runCtx, *cycleTimeout)
+			}
+			fmt.Println("Cluster Durumu:")
+			available, skipped, err := checks.FilterByDiscovery(clients.Kubernetes, cycleChecks)
+			if err != nil {
+				fmt.Println(err)
+			} else if joined := strings.Join(skipped, ", "); joined != lastSkipped {
+				// Atlanan kontroller her döngüde değil, yalnızca liste değiştiğinde yazılır.
+				if joined != "" {
+					fmt.Printf("API grupları sunulmadığı için atlanan kontroller: %s\n", joined)
+				}
+				lastSkipped = joined
+			}
+			// Sharding açıkken lider olmayan replica'lar yalnızca namespace'lere
+			// göre bölünebilen kontrolleri kendi payları için çalıştırır; cluster
+			// geneli toplamlar üreten kontrolleri yalnızca lider çalıştırır.
+			reporting := exchange == nil || leading.Load()
+			if !reporting {
+				var sharded []checks.Check
+				for _, check := range available {
+					if checks.IsShardedByNamespace(check) {
+						sharded = append(sharded, check)
+					}
+				}
+				available = sharded
+			}
+			if printer.tracker != nil {
+				if !reporting {
+					trackerLoaded = false
+				} else if !trackerLoaded {
+					if err := printer.tracker.Reload(runCtx); err != nil {
+						fmt.Printf("Bulgu durumları yüklenemedi: %v\n", err)
+					} else {
+						trackerLoaded = true
+					}
+				}
+			}
+			results := checks.RunAll(cycleCtx, clients.Kubernetes, available, *concurrency, *checkTimeout)
+			if exchange != nil {
+				results = exchangeShardResults(cycleCtx, *exchange, options.ShardIndex, results, reporting, *shardResultMaxAge)
+			}
+			for _, result := range results {
+				printer.print(result)
+			}
 
-	for {
-		fmt.Println("Cluster Durumu:")
-		checkPods(clientset)
-		checkNamespaces(clientset)
-		checkNodes(clientset)
-		checkEvents(clientset)
-		checkPersistentVolumeClaims(clientset)
+			// Detaylı pod kontrolü, düzeltme eylemleri ve döngü boyunca gözlenen
+			// sınırlamalar diğer kontroller bittikten sonra sırayla çalışır.
+			// Sharding açıkken lider olmayan replica'lar yalnızca kendi
+			// namespace'lerindeki düzeltme eylemlerini çalıştırır.
+			final := finalChecks
+			if !reporting {
+				final = nil
+				for _, check := range finalChecks {
+					if checks.IsShardedByNamespace(check) {
+						final = append(final, check)
+					}
+				}
+			}
+			if len(final) > 0 {
+				fmt.Println("\nDetaylı pod kontrolü:")
+				for _, result := range checks.RunAll(cycleCtx, clients.Kubernetes, final, 1, *checkTimeout) {
+					printer.print(result)
+				}
+			}
+			cancel()
+			if printer.tracker != nil && reporting && trackerLoaded {
+				if err := printer.tracker.Save(runCtx); err != nil {
+					fmt.Printf("Bulgu durumları kaydedilemedi: %v\n", err)
+				}
+			}
 
-		fmt.Println("\nDetaylı pod kontrolü:")
-		namespace := "default"
-		pod := "alpine-deployment-548dbddc9b-dnq9r"
-		checkSpecificPod(clientset, namespace, pod)
+			// API server istekleri sınırladıysa bir sonraki döngü, önerilen
+			// Retry-After süresinden ve önceki aralığın iki katından kısa
+			// olmayacak şekilde ertelenir; sınırlama bittiğinde aralık
+			// kademeli olarak normale döner.
+			if throttled := clients.Throttle.Stats(); throttled.Count > throttledBefore.Count {
+				interval = min(max(2*interval, throttled.LastRetryAfter), max(*maxCycleInterval, *cycleInterval))
+				fmt.Printf("\nAPI server sınırlaması nedeniyle döngü aralığı %s olarak uzatıldı\n", interval)
+			} else {
+				interval = max(interval/2, *cycleInterval)
+			}
 
-		fmt.Println("\n-----------------------------------")
-		time.Sleep(10 * time.Second)
+			fmt.Println("\n-----------------------------------")
+			select {
+			case <-shutdown.Done():
+			case <-runCtx.Done():
+			case <-time.After(interval):
+			}
+		}
 	}
-}
 
-func checkPods(clientset *kubernetes.Clientset) {
-	pods, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("Pod'ları listelerken hata oluştu: %v\n", err)
-		return
+	identity := *leaderElectIdentity
+	if identity == "" {
+		identity, _ = os.Hostname()
+	}
+	leaderConfig := leader.Config{
+		Namespace:     *leaderElectNamespace,
+		Name:          *leaderElectLeaseName,
+		Identity:      identity,
+		LeaseDuration: *leaderElectLeaseDuration,
+		RenewDeadline: *leaderElectRenewDeadline,
+		RetryPeriod:   *leaderElectRetryPeriod,
+	}
+	switch {
+	case exchange != nil:
+		// Sharding açıkken tüm replica'lar döngüleri çalıştırır; liderlik
+		// yalnızca sonuçları kimin birleştirip yazacağını belirler.
+		elected := make(chan error, 1)
+		go func() {
+			elected <- leader.Run(ctx, shutdown.Done(), clients.Kubernetes, leaderConfig, func(leaderCtx context.Context) {
+				leading.Store(true)
+				defer leading.Store(false)
+				select {
+				case <-leaderCtx.Done():
+				case <-shutdown.Done():
+				}
+			})
+		}()
+		runCycles(ctx)
+		if err := <-elected; err != nil {
+			panic(err.Error())
+		}
+	case *leaderElect:
+		if err := leader.Run(ctx, shutdown.Done(), clients.Kubernetes, leaderConfig, runCycles); err != nil {
+			panic(err.Error())
+		}
+	default:
+		runCycles(ctx)
 	}
-	fmt.Printf("Cluster'da %d pod var\n", len(pods.Items))
+	os.Stdout.Sync()
 }
 
-func checkNamespaces(clientset *kubernetes.Clientset) {
-	namespaces, err := clientset.CoreV1().Namespaces().List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("Namespace'leri listelerken hata oluştu: %v\n", err)
-		return
+// exchangeShardResults, bu replica'nın sonuçlarını yayınlar. Lider değilse
+// yazılacak sonuç döndürmez; liderse diğer replica'ların maxAge'den yeni
+// raporlarıyla birleştirilmiş sonuçları döndürür.
+func exchangeShardResults(ctx context.Context, exchange shard.Exchange, index int, results []checks.Result, leading bool, maxAge time.Duration) []checks.Result {
+	own := shard.Report{Shard: index, Shards: exchange.Shards, Time: time.Now()}
+	for _, result := range results {
+		published := shard.Result{Check: result.Check.Name(), Findings: result.Findings}
+		if result.Err != nil {
+			published.Error = result.Err.Error()
+		}
+		own.Results = append(own.Results, published)
+	}
+	if err := exchange.Publish(ctx, own); err != nil {
+		fmt.Printf("Shard %d sonuçları yayınlanamadı: %v\n", index, err)
+	}
+	if !leading {
+		fmt.Printf("Shard %d/%d: %d kontrolün sonuçları lider tarafından birleştirilmek üzere yayınlandı\n", index, exchange.Shards, len(results))
+		return nil
 	}
-	fmt.Printf("Cluster'da %d namespace var\n", len(namespaces.Items))
-}
 
-func checkNodes(clientset *kubernetes.Clientset) {
-	nodes, err := clientset.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	others, missing, err := exchange.Collect(ctx, index, maxAge)
 	if err != nil {
-		fmt.Printf("Node'ları listelerken hata oluştu: %v\n", err)
-		return
+		fmt.Println(err)
 	}
-	if len(nodes.Items) == 0 {
-		fmt.Println(NoNodesInKubernetes{}.Error())
-	} else {
-		fmt.Printf("Cluster'da %d node var\n", len(nodes.Items))
+	if len(missing) > 0 {
+		fmt.Printf("UYARI: %v numaralı shard'ların son %s içinde raporu yok; bu replica'ların namespace'leri bu döngüde raporlanmadı\n", missing, maxAge)
+	}
+	var merged []checks.Result
+	for _, result := range shard.Merge(append([]shard.Report{own}, others...)) {
+		var err error
+		if result.Error != "" {
+			err = errors.New(result.Error)
+		}
+		merged = append(merged, checks.Result{Check: mergedCheck(result.Check), Findings: result.Findings, Err: err})
 	}
+	return merged
 }
 
-func checkEvents(clientset *kubernetes.Clientset) {
-	events, err := clientset.CoreV1().Events("").List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("Event'leri listelerken hata oluştu: %v\n", err)
-		return
-	}
-	fmt.Printf("Son 1 saatte %d event var\n", len(events.Items))
+// mergedCheck, replica'lardan birleştirilen sonuçları yalnızca adıyla
+// temsil eder; sonuçları yazarken kontrolün kendisine ihtiyaç duyulmaz.
+type mergedCheck string
+
+func (c mergedCheck) Name() string {
+	return string(c)
 }
 
-func checkPersistentVolumeClaims(clientset *kubernetes.Clientset) {
-	pvcs, err := clientset.CoreV1().PersistentVolumeClaims("").List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
-		fmt.Printf("PersistentVolumeClaim'leri listelerken hata oluştu: %v\n", err)
+func (c mergedCheck) Run(context.Context, kubernetes.Interface) ([]report.Finding, error) {
+	return nil, nil
+}
+
+// handleSignals, ilk SIGINT/SIGTERM'de kapanan shutdown context'ini ve API
+// isteklerinde kullanılan ctx'i döndürür. Sinyalden sonra yeni döngü
+// başlatılmaz; devam eden döngü grace süresi içinde tamamlanıp bulguları
+// yazılır, böylece pod sonlandırılırken çıktı yarıda kesilmez. Grace süresi
+// dolduğunda ya da ikinci sinyalde ctx iptal edilerek süren istekler
+// durdurulur.
+func handleSignals(grace time.Duration) (shutdown, ctx context.Context) {
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	shutdown, beginShutdown := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		received := <-signals
+		fmt.Printf("\n%s alındı; devam eden döngü en fazla %s içinde tamamlanıp çıkılacak (hemen durdurmak için tekrar gönderin)\n", received, grace)
+		beginShutdown()
+		select {
+		case <-signals:
+		case <-time.After(grace):
+		}
+		cancel()
+	}()
+	return shutdown, ctx
+}
+
+// resultPrinter, kontrol sonuçlarını yazar. Durum saklama açıksa bulgular
+// tracker ile eşleştirilir: onaylananlar gizlenir, önceki döngülerden
+// süregelen uyarı ve kritik bulguların ne zamandan beri görüldüğü eklenir ve
+// artık görülmeyenler çözüldü olarak bildirilir.
+type resultPrinter struct {
+	tracker *state.Tracker
+	onlyNew bool
+}
+
+// print, kontrolün bulgularını, varsa hatasıyla birlikte yazar.
+func (p resultPrinter) print(result checks.Result) {
+	defer func() {
+		if result.Err != nil {
+			fmt.Println(result.Err)
+		}
+	}()
+	// Hata veren kontrollerin eksik bulguları çözülmüş sayılmasın diye
+	// yalnızca başarılı çalışmalar tracker'a işlenir.
+	if p.tracker == nil || result.Err != nil {
+		report.Print(os.Stdout, result.Findings)
 		return
 	}
-	fmt.Printf("Cluster'da %d PersistentVolumeClaim var\n", len(pvcs.Items))
-	
-	for _, pvc := range pvcs.Items {
-		expectedPhase := corev1.ClaimBound
-		if pvc.Status.Phase != expectedPhase {
-			err := PersistentVolumeClaimNotInStatus{&pvc, &expectedPhase}
-			fmt.Println(err.Error())
+	now := time.Now()
+	observed, resolved := p.tracker.Observe(result.Check.Name(), result.Findings, now)
+	var findings []report.Finding
+	for _, o := range observed {
+		if o.Entry.Acknowledged || (p.onlyNew && !o.New) {
+			continue
+		}
+		if !o.New && o.Severity != report.Info {
+			o.Message = fmt.Sprintf("%s (%s süredir)", o.Message, now.Sub(o.Entry.FirstSeen).Round(time.Second))
+		}
+		findings = append(findings, o.Finding)
+	}
+	for _, entry := range resolved {
+		if !entry.Acknowledged && entry.Severity != report.Info {
+			findings = append(findings, report.Finding{Check: entry.Check, Severity: report.Info, Message: fmt.Sprintf("Çözüldü (%s sürdü): %s", entry.ResolvedAt.Sub(entry.FirstSeen).Round(time.Second), entry.Message)})
 		}
 	}
+	report.Print(os.Stdout, findings)
 }
 
-func checkSpecificPod(clientset *kubernetes.Clientset, namespace, podName string) {
-	pod, err := clientset.CoreV1().Pods(namespace).Get(context.TODO(), podName, metav1.GetOptions{})
-	if errors.IsNotFound(err) {
-		fmt.Printf("Pod %s namespace %s içinde bulunamadı\n", podName, namespace)
-	} else if statusError, isStatus := err.(*errors.StatusError); isStatus {
-		fmt.Printf("Pod %s namespace %s içinde alınan hata: %v\n", podName, namespace, statusError.ErrStatus.Message)
-	} else if err != nil {
-		fmt.Printf("Pod bilgisi alınırken hata oluştu: %v\n", err)
-	} else {
-		fmt.Printf("Pod %s namespace %s içinde bulundu\n", podName, namespace)
-		fmt.Printf("Pod durumu: %s\n", pod.Status.Phase)
-		fmt.Printf("Pod IP: %s\n", pod.Status.PodIP)
-		fmt.Printf("Node: %s\n", pod.Spec.NodeName)
+// defaultNamespace, cluster içinde çalışırken pod'un namespace'ini, aksi
+// halde "default" döndürür.
+func defaultNamespace() string {
+	if namespace := os.Getenv("POD_NAMESPACE"); namespace != "" {
+		return namespace
 	}
-}
\ No newline at end of file
+	if data, err := os.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/namespace"); err == nil {
+		return strings.TrimSpace(string(data))
+	}
+	return metav1.NamespaceDefault
+}
@@ -0,0 +1,184 @@
+package checks
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// autoscalerNodeGroup, cluster-autoscaler durum ConfigMap'indeki bir node
+// grubunun bu araçta kullanılan alanlarıdır.
+type autoscalerNodeGroup struct {
+	name    string
+	target  int
+	maxSize int
+	scaleUp string
+}
+
+// autoscalerHealthPattern, cluster-autoscaler'ın metin biçimindeki durum
+// çıktısında node grubu hedef ve sınır değerlerini yakalar.
+var autoscalerHealthPattern = regexp.MustCompile(`cloudProviderTarget=(\d+) \(minSize=(\d+), maxSize=(\d+)\)`)
+
+// checkClusterAutoscaler, kube-system/cluster-autoscaler-status ConfigMap'ini
+// ve cluster-autoscaler event'lerini okuyarak başarısız scale-up'ları,
+// maksimum boyuta ulaşmış node gruplarını ve --unschedulable-pod-threshold
+// süresinden uzun süredir kapasite bekleyen pod'ları raporlar.
+func (s *Suite) checkClusterAutoscaler(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	status, err := clientset.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, "cluster-autoscaler-status", metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "cluster-autoscaler durumunu alırken hata oluştu")
+	}
+	for _, group := range parseAutoscalerStatus(status.Data["status"]) {
+		if group.maxSize > 0 && group.target >= group.maxSize {
+			out.objectf(report.Warning, report.ResourceRef{Kind: "NodeGroup", Name: group.name}, "MaxSizeReached", "Node grubu %s maksimum boyutunda (%d/%d)", group.name, group.target, group.maxSize)
+		}
+		if strings.HasPrefix(group.scaleUp, "Backoff") {
+			out.objectf(report.Warning, report.ResourceRef{Kind: "NodeGroup", Name: group.name}, "ScaleUpBackoff", "Node grubu %s scale-up backoff durumunda: %s", group.name, group.scaleUp)
+		}
+	}
+
+	events, err := s.listEvents(ctx, clientset, "", "FailedToScaleUpGroup", "ScaleUpFailed", "NotTriggerScaleUp")
+	if err != nil {
+		return checkerrors.ListFailed("Event'leri", err)
+	}
+	notTriggered := map[string]string{}
+	for _, event := range events {
+		if event.Source.Component != "cluster-autoscaler" {
+			continue
+		}
+		switch event.Reason {
+		case "FailedToScaleUpGroup", "ScaleUpFailed":
+			out.infof("Başarısız scale-up (%d kez): %s", event.Count, event.Message)
+		case "NotTriggerScaleUp":
+			notTriggered[event.InvolvedObject.Namespace+"/"+event.InvolvedObject.Name] = event.Message
+		}
+	}
+
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Pending,spec.nodeName="})
+	if err != nil {
+		return checkerrors.ListFailed("Pending pod'ları", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		since, ok := unschedulableSince(pod)
+		if !ok || time.Since(since) < s.opts.UnschedulablePodThreshold {
+			continue
+		}
+		reason := "scale-up bekleniyor"
+		if message, ok := notTriggered[pod.Namespace+"/"+pod.Name]; ok {
+			reason = "scale-up tetiklenmedi: " + message
+		}
+		out.objectf(report.Info, podRef(pod), "WaitingForCapacity", "Pod %s namespace %s %s süredir kapasite bekliyor (%s)", pod.Name, pod.Namespace, time.Since(since).Round(time.Second), reason)
+	}
+	return nil
+}
+
+// unschedulableSince, scheduler'ın pod'u Unschedulable olarak işaretlediği
+// zamanı döndürür.
+func unschedulableSince(pod *corev1.Pod) (time.Time, bool) {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Reason == corev1.PodReasonUnschedulable {
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseAutoscalerStatus, cluster-autoscaler durum çıktısındaki node
+// gruplarını çözer. 1.30 öncesi sürümlerin metin biçimi ile sonraki
+// sürümlerin YAML biçimi desteklenir.
+func parseAutoscalerStatus(status string) []autoscalerNodeGroup {
+	var structured struct {
+		NodeGroups []struct {
+			Name   string `json:"name"`
+			Health struct {
+				CloudProviderTarget int `json:"cloudProviderTarget"`
+				MaxSize             int `json:"maxSize"`
+			} `json:"health"`
+			ScaleUp struct {
+				Status string `json:"status"`
+			} `json:"scaleUp"`
+		} `json:"nodeGroups"`
+	}
+	if err := yaml.Unmarshal([]byte(status), &structured); err == nil && len(structured.NodeGroups) > 0 {
+		groups := make([]autoscalerNodeGroup, 0, len(structured.NodeGroups))
+		for _, group := range structured.NodeGroups {
+			groups = append(groups, autoscalerNodeGroup{
+				name:    group.Name,
+				target:  group.Health.CloudProviderTarget,
+				maxSize: group.Health.MaxSize,
+				scaleUp: group.ScaleUp.Status,
+			})
+		}
+		return groups
+	}
+
+	var groups []autoscalerNodeGroup
+	inNodeGroups := false
+	for _, line := range strings.Split(status, "\n") {
+		line = strings.TrimSpace(line)
+		key, value, _ := strings.Cut(line, ":")
+		value = strings.TrimSpace(value)
+		switch {
+		case key == "NodeGroups":
+			inNodeGroups = true
+		case !inNodeGroups:
+		case key == "Name":
+			groups = append(groups, autoscalerNodeGroup{name: value})
+		case len(groups) == 0:
+		case key == "Health":
+			if match := autoscalerHealthPattern.FindStringSubmatch(value); match != nil {
+				groups[len(groups)-1].target, _ = strconv.Atoi(match[1])
+				groups[len(groups)-1].maxSize, _ = strconv.Atoi(match[3])
+			}
+		case key == "ScaleUp":
+			groups[len(groups)-1].scaleUp = value
+		}
+	}
+	return groups
+}
+
+// checkUnschedulableTrend, schedule edilemeyen pod sayısını döngüler boyunca
+// izler. Sayı son --unschedulable-trend-cycles döngünün her birinde bir
+// öncekinden fazlaysa, tekil Pending pod bulgularından ayrı olarak scheduler
+// ya da kapasite sorunu uyarısı verilir.
+func (s *Suite) checkUnschedulableTrend(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Pending,spec.nodeName="})
+	if err != nil {
+		return checkerrors.ListFailed("Pending pod'ları", err)
+	}
+	count := 0
+	for i := range pods.Items {
+		if _, ok := unschedulableSince(&pods.Items[i]); ok {
+			count++
+		}
+	}
+	s.unschedulableHistory = append(s.unschedulableHistory, count)
+	if len(s.unschedulableHistory) > s.opts.UnschedulableTrendCycles {
+		s.unschedulableHistory = s.unschedulableHistory[len(s.unschedulableHistory)-s.opts.UnschedulableTrendCycles:]
+	}
+	out.infof("Schedule edilemeyen pod sayısı: %d (son döngüler: %v)", count, s.unschedulableHistory)
+	if len(s.unschedulableHistory) < s.opts.UnschedulableTrendCycles || s.opts.UnschedulableTrendCycles < 2 {
+		return nil
+	}
+	for i := 1; i < len(s.unschedulableHistory); i++ {
+		if s.unschedulableHistory[i] <= s.unschedulableHistory[i-1] {
+			return nil
+		}
+	}
+	out.warningf("Schedule edilemeyen pod sayısı son %d döngüde sürekli arttı (%d → %d); scheduler ya da kapasite sorunu olabilir", len(s.unschedulableHistory), s.unschedulableHistory[0], count)
+	return nil
+}
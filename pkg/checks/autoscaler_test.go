@@ -0,0 +1,113 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseAutoscalerStatus(t *testing.T) {
+	text := `Cluster-autoscaler status at 2023-05-01 10:00:00.000000000 +0000 UTC:
+Cluster-wide:
+  Health:      Healthy (ready=4 unready=0 notStarted=0 longNotStarted=0 registered=4 longUnregistered=0)
+  ScaleUp:     NoActivity (ready=4 registered=4)
+
+NodeGroups:
+  Name:        general
+  Health:      Healthy (ready=3 unready=0 notStarted=0 longNotStarted=0 registered=3 longUnregistered=0 cloudProviderTarget=3 (minSize=1, maxSize=3))
+  ScaleUp:     NoActivity (ready=3 cloudProviderTarget=3)
+
+  Name:        gpu
+  Health:      Healthy (ready=1 unready=0 notStarted=0 longNotStarted=0 registered=1 longUnregistered=0 cloudProviderTarget=2 (minSize=0, maxSize=4))
+  ScaleUp:     InProgress (ready=1 cloudProviderTarget=2)
+`
+	structured := `time: "2024-05-01 10:00:00.000000000 +0000 UTC"
+autoscalerStatus: Running
+clusterWide:
+  health:
+    status: Healthy
+nodeGroups:
+- name: general
+  health:
+    status: Healthy
+    cloudProviderTarget: 3
+    minSize: 1
+    maxSize: 3
+  scaleUp:
+    status: NoActivity
+- name: gpu
+  health:
+    status: Healthy
+    cloudProviderTarget: 2
+    minSize: 0
+    maxSize: 4
+  scaleUp:
+    status: InProgress
+`
+	want := []autoscalerNodeGroup{
+		{name: "general", target: 3, maxSize: 3, scaleUp: "NoActivity"},
+		{name: "gpu", target: 2, maxSize: 4, scaleUp: "InProgress"},
+	}
+
+	if got := parseAutoscalerStatus(structured); !reflect.DeepEqual(got, want) {
+		t.Errorf("YAML biçimi: %+v, beklenen %+v", got, want)
+	}
+	got := parseAutoscalerStatus(text)
+	if len(got) != len(want) {
+		t.Fatalf("metin biçimi: %d node grubu beklenirken %d döndü: %+v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i].name != want[i].name || got[i].target != want[i].target || got[i].maxSize != want[i].maxSize {
+			t.Errorf("metin biçimi: %+v, beklenen %+v", got[i], want[i])
+		}
+	}
+	if parseAutoscalerStatus("") != nil {
+		t.Error("boş durum için node grubu dönmemeli")
+	}
+}
+
+func TestUnschedulableTrendRequiresStrictIncrease(t *testing.T) {
+	for _, test := range []struct {
+		history []int
+		pods    int
+		warned  bool
+	}{
+		{[]int{1, 2}, 3, true},
+		{[]int{1, 1}, 2, false},
+		{[]int{1, 2}, 2, false},
+		{[]int{3, 2}, 4, false},
+	} {
+		clientset := fake.NewSimpleClientset()
+		for i := 0; i < test.pods; i++ {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("pod-%d", i)},
+				Status: corev1.PodStatus{Phase: corev1.PodPending, Conditions: []corev1.PodCondition{{
+					Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable,
+				}}},
+			}
+			if _, err := clientset.CoreV1().Pods(pod.Namespace).Create(context.Background(), pod, metav1.CreateOptions{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		opts := DefaultOptions()
+		opts.UnschedulableTrendCycles = 3
+		s := &Suite{opts: opts, unschedulableHistory: append([]int(nil), test.history...)}
+		out := &findings{check: "unschedulable-trend"}
+		if err := s.checkUnschedulableTrend(context.Background(), clientset, out); err != nil {
+			t.Fatal(err)
+		}
+		warned := false
+		for _, finding := range out.list {
+			warned = warned || finding.Severity == report.Warning
+		}
+		if warned != test.warned {
+			t.Errorf("geçmiş %v ve %d pod için uyarı %v beklenirken %v", test.history, test.pods, test.warned, warned)
+		}
+	}
+}
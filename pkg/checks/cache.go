@@ -0,0 +1,240 @@
+package checks
+
+import (
+	"context"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// StartInformers, kontrollerin her döngüde tam List çağrısı yaptığı
+// resource'lar için paylaşılan informer'ları başlatır ve cache'lerin dolmasını
+// bekler. Cache'i timeout içinde dolmayan (örneğin RBAC izni olmayan)
+// resource'lar için kontroller API'ye doğrudan List çağrısı yapmaya devam
+// eder. Informer'lar ctx iptal edilene kadar çalışır.
+func (s *Suite) StartInformers(ctx context.Context, clientset kubernetes.Interface, resync, timeout time.Duration) error {
+	factory := informers.NewSharedInformerFactory(clientset, resync)
+	informersByResource := map[string]cache.SharedIndexInformer{
+		"nodes":                  factory.Core().V1().Nodes().Informer(),
+		"pods":                   factory.Core().V1().Pods().Informer(),
+		"services":               factory.Core().V1().Services().Informer(),
+		"persistentVolumeClaims": factory.Core().V1().PersistentVolumeClaims().Informer(),
+		"persistentVolumes":      factory.Core().V1().PersistentVolumes().Informer(),
+		"namespaces":             factory.Core().V1().Namespaces().Informer(),
+		"serviceAccounts":        factory.Core().V1().ServiceAccounts().Informer(),
+		"deployments":            factory.Apps().V1().Deployments().Informer(),
+		"statefulSets":           factory.Apps().V1().StatefulSets().Informer(),
+		"daemonSets":             factory.Apps().V1().DaemonSets().Informer(),
+		"replicaSets":            factory.Apps().V1().ReplicaSets().Informer(),
+		"clusterRoles":           factory.Rbac().V1().ClusterRoles().Informer(),
+		"clusterRoleBindings":    factory.Rbac().V1().ClusterRoleBindings().Informer(),
+		"roles":                  factory.Rbac().V1().Roles().Informer(),
+		"roleBindings":           factory.Rbac().V1().RoleBindings().Informer(),
+		"storageClasses":         factory.Storage().V1().StorageClasses().Informer(),
+		"endpointSlices":         factory.Discovery().V1().EndpointSlices().Informer(),
+		"ingresses":              factory.Networking().V1().Ingresses().Informer(),
+	}
+	// Informer'lar ayrı ayrı başlatılır; böylece cache'i dolmayanlar, izinsiz
+	// List denemelerini sonsuza kadar tekrarlamamaları için durdurulabilir.
+	stops := map[string]context.CancelFunc{}
+	for resource, informer := range informersByResource {
+		informerCtx, stop := context.WithCancel(ctx)
+		stops[resource] = stop
+		go informer.Run(informerCtx.Done())
+	}
+
+	syncCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	s.indexers = map[string]cache.Indexer{}
+	var unsynced []string
+	for resource, informer := range informersByResource {
+		if cache.WaitForCacheSync(syncCtx.Done(), informer.HasSynced) {
+			s.indexers[resource] = informer.GetIndexer()
+		} else {
+			stops[resource]()
+			unsynced = append(unsynced, resource)
+		}
+	}
+	if len(unsynced) > 0 {
+		return checkerrors.New(checkerrors.CodeTimeout, "%d resource için informer cache'i dolmadı, bunlar için List kullanılacak: %v", len(unsynced), unsynced)
+	}
+	return nil
+}
+
+// cachedItems, indexer'daki nesneleri namespace'e göre süzerek değer olarak
+// döndürür. Namespace'lere göre bölünebilir bir kontrolde sonuçlar listAll
+// ile aynı kuralla (bkz. filtersShard) bu replica'nın namespace'lerine göre
+// süzülür. Nesneler cache ile paylaşıldığından çağıranlar değiştirmemelidir.
+func cachedItems[T any](ctx context.Context, opts Options, indexer cache.Indexer, namespace string) []T {
+	var objects []interface{}
+	if namespace == metav1.NamespaceAll {
+		objects = indexer.List()
+	} else {
+		objects, _ = indexer.ByIndex(cache.NamespaceIndex, namespace)
+	}
+	sharded := filtersShard(ctx, opts)
+	items := make([]T, 0, len(objects))
+	for _, object := range objects {
+		if accessor, err := meta.Accessor(object); err == nil && sharded && !ownsNamespace(opts, accessor.GetNamespace()) {
+			continue
+		}
+		items = append(items, *object.(*T))
+	}
+	return items
+}
+
+// countObjects, resource'un cluster genelindeki nesne sayısını döndürür.
+// Informer cache'i varsa nesneler oradan sayılır; yoksa yalnızca metadata
+// listelenir, böylece sadece sayı gereken yerde büyük cluster'larda tam
+// spec'ler deserialize edilip bellekte tutulmaz.
+func (s *Suite) countObjects(ctx context.Context, cacheKey string, gvr schema.GroupVersionResource) (int, error) {
+	if indexer, ok := s.indexers[cacheKey]; ok {
+		return len(indexer.ListKeys()), nil
+	}
+	list, err := listAll(ctx, s.opts, s.metadata.Resource(gvr).List, metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return len(list.Items), nil
+}
+
+// list* yardımcıları, resource'un informer cache'i varsa nesneleri cache'ten,
+// yoksa API server'a List çağrısı yaparak döndürür.
+
+func (s *Suite) listNodes(ctx context.Context, clientset kubernetes.Interface) (*corev1.NodeList, error) {
+	if indexer, ok := s.indexers["nodes"]; ok {
+		return &corev1.NodeList{Items: cachedItems[corev1.Node](ctx, s.opts, indexer, metav1.NamespaceAll)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.CoreV1().Nodes().List, metav1.ListOptions{})
+}
+
+func (s *Suite) listPods(ctx context.Context, clientset kubernetes.Interface, namespace string) (*corev1.PodList, error) {
+	if indexer, ok := s.indexers["pods"]; ok {
+		return &corev1.PodList{Items: cachedItems[corev1.Pod](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.CoreV1().Pods(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listServices(ctx context.Context, clientset kubernetes.Interface, namespace string) (*corev1.ServiceList, error) {
+	if indexer, ok := s.indexers["services"]; ok {
+		return &corev1.ServiceList{Items: cachedItems[corev1.Service](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.CoreV1().Services(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listPersistentVolumeClaims(ctx context.Context, clientset kubernetes.Interface, namespace string) (*corev1.PersistentVolumeClaimList, error) {
+	if indexer, ok := s.indexers["persistentVolumeClaims"]; ok {
+		return &corev1.PersistentVolumeClaimList{Items: cachedItems[corev1.PersistentVolumeClaim](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.CoreV1().PersistentVolumeClaims(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listPersistentVolumes(ctx context.Context, clientset kubernetes.Interface) (*corev1.PersistentVolumeList, error) {
+	if indexer, ok := s.indexers["persistentVolumes"]; ok {
+		return &corev1.PersistentVolumeList{Items: cachedItems[corev1.PersistentVolume](ctx, s.opts, indexer, metav1.NamespaceAll)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.CoreV1().PersistentVolumes().List, metav1.ListOptions{})
+}
+
+func (s *Suite) listNamespaces(ctx context.Context, clientset kubernetes.Interface) (*corev1.NamespaceList, error) {
+	if indexer, ok := s.indexers["namespaces"]; ok {
+		return &corev1.NamespaceList{Items: cachedItems[corev1.Namespace](ctx, s.opts, indexer, metav1.NamespaceAll)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.CoreV1().Namespaces().List, metav1.ListOptions{})
+}
+
+func (s *Suite) listServiceAccounts(ctx context.Context, clientset kubernetes.Interface, namespace string) (*corev1.ServiceAccountList, error) {
+	if indexer, ok := s.indexers["serviceAccounts"]; ok {
+		return &corev1.ServiceAccountList{Items: cachedItems[corev1.ServiceAccount](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.CoreV1().ServiceAccounts(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listDeployments(ctx context.Context, clientset kubernetes.Interface, namespace string) (*appsv1.DeploymentList, error) {
+	if indexer, ok := s.indexers["deployments"]; ok {
+		return &appsv1.DeploymentList{Items: cachedItems[appsv1.Deployment](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.AppsV1().Deployments(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listStatefulSets(ctx context.Context, clientset kubernetes.Interface, namespace string) (*appsv1.StatefulSetList, error) {
+	if indexer, ok := s.indexers["statefulSets"]; ok {
+		return &appsv1.StatefulSetList{Items: cachedItems[appsv1.StatefulSet](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.AppsV1().StatefulSets(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listDaemonSets(ctx context.Context, clientset kubernetes.Interface, namespace string) (*appsv1.DaemonSetList, error) {
+	if indexer, ok := s.indexers["daemonSets"]; ok {
+		return &appsv1.DaemonSetList{Items: cachedItems[appsv1.DaemonSet](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.AppsV1().DaemonSets(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listReplicaSets(ctx context.Context, clientset kubernetes.Interface, namespace string) (*appsv1.ReplicaSetList, error) {
+	if indexer, ok := s.indexers["replicaSets"]; ok {
+		return &appsv1.ReplicaSetList{Items: cachedItems[appsv1.ReplicaSet](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.AppsV1().ReplicaSets(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listClusterRoles(ctx context.Context, clientset kubernetes.Interface) (*rbacv1.ClusterRoleList, error) {
+	if indexer, ok := s.indexers["clusterRoles"]; ok {
+		return &rbacv1.ClusterRoleList{Items: cachedItems[rbacv1.ClusterRole](ctx, s.opts, indexer, metav1.NamespaceAll)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.RbacV1().ClusterRoles().List, metav1.ListOptions{})
+}
+
+func (s *Suite) listClusterRoleBindings(ctx context.Context, clientset kubernetes.Interface) (*rbacv1.ClusterRoleBindingList, error) {
+	if indexer, ok := s.indexers["clusterRoleBindings"]; ok {
+		return &rbacv1.ClusterRoleBindingList{Items: cachedItems[rbacv1.ClusterRoleBinding](ctx, s.opts, indexer, metav1.NamespaceAll)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.RbacV1().ClusterRoleBindings().List, metav1.ListOptions{})
+}
+
+func (s *Suite) listRoles(ctx context.Context, clientset kubernetes.Interface, namespace string) (*rbacv1.RoleList, error) {
+	if indexer, ok := s.indexers["roles"]; ok {
+		return &rbacv1.RoleList{Items: cachedItems[rbacv1.Role](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.RbacV1().Roles(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listRoleBindings(ctx context.Context, clientset kubernetes.Interface, namespace string) (*rbacv1.RoleBindingList, error) {
+	if indexer, ok := s.indexers["roleBindings"]; ok {
+		return &rbacv1.RoleBindingList{Items: cachedItems[rbacv1.RoleBinding](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.RbacV1().RoleBindings(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listStorageClasses(ctx context.Context, clientset kubernetes.Interface) (*storagev1.StorageClassList, error) {
+	if indexer, ok := s.indexers["storageClasses"]; ok {
+		return &storagev1.StorageClassList{Items: cachedItems[storagev1.StorageClass](ctx, s.opts, indexer, metav1.NamespaceAll)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.StorageV1().StorageClasses().List, metav1.ListOptions{})
+}
+
+func (s *Suite) listEndpointSlices(ctx context.Context, clientset kubernetes.Interface, namespace string) (*discoveryv1.EndpointSliceList, error) {
+	if indexer, ok := s.indexers["endpointSlices"]; ok {
+		return &discoveryv1.EndpointSliceList{Items: cachedItems[discoveryv1.EndpointSlice](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.DiscoveryV1().EndpointSlices(namespace).List, metav1.ListOptions{})
+}
+
+func (s *Suite) listIngresses(ctx context.Context, clientset kubernetes.Interface, namespace string) (*networkingv1.IngressList, error) {
+	if indexer, ok := s.indexers["ingresses"]; ok {
+		return &networkingv1.IngressList{Items: cachedItems[networkingv1.Ingress](ctx, s.opts, indexer, namespace)}, nil
+	}
+	return listAll(ctx, s.opts, clientset.NetworkingV1().Ingresses(namespace).List, metav1.ListOptions{})
+}
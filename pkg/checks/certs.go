@@ -0,0 +1,280 @@
+package checks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes"
+)
+
+// checkTLSSecrets, kubernetes.io/tls tipindeki Secret'lardaki sertifikaları
+// okuyarak süresi dolmuş ya da --cert-expiry-window içinde dolacak olanları
+// raporlar. Ingress'lerin TLS bölümünde referans verilen Secret'ların var
+// olduğu ve sertifikanın Ingress host'larını kapsadığı da doğrulanır.
+func (s *Suite) checkTLSSecrets(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	secrets, err := listAll(ctx, s.opts, clientset.CoreV1().Secrets("").List, metav1.ListOptions{FieldSelector: "type=" + string(corev1.SecretTypeTLS)})
+	if err != nil {
+		return checkerrors.ListFailed("TLS Secret'larını", err)
+	}
+	certificates := map[string]*x509.Certificate{}
+	for _, secret := range secrets.Items {
+		ref := report.ResourceRef{Kind: "Secret", Namespace: secret.Namespace, Name: secret.Name}
+		certs, err := parseCertificates(secret.Data[corev1.TLSCertKey])
+		if err != nil || len(certs) == 0 {
+			out.objectf(report.Info, ref, "InvalidCertificate", "Secret %s namespace %s içindeki sertifika okunamadı: %v", secret.Name, secret.Namespace, err)
+			continue
+		}
+		leaf := certs[0]
+		certificates[secret.Namespace+"/"+secret.Name] = leaf
+		s.reportCertificateExpiry(out, &ref, fmt.Sprintf("Secret %s namespace %s içindeki sertifika", secret.Name, secret.Namespace), leaf)
+	}
+
+	ingresses, err := s.listIngresses(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("TLS kontrolü için Ingress'leri", err)
+	}
+	for _, ingress := range ingresses.Items {
+		// Bir Ingress'in tüm eksik Secret'ları ve eşleşmeyen host'ları, bulgu
+		// kimliği nesne ve nedenden oluştuğu için tek bir bulguda toplanır.
+		var missing, mismatched []string
+		for _, tls := range ingress.Spec.TLS {
+			if tls.SecretName == "" {
+				continue
+			}
+			leaf, ok := certificates[ingress.Namespace+"/"+tls.SecretName]
+			if !ok {
+				missing = append(missing, tls.SecretName)
+				continue
+			}
+			for _, host := range tls.Hosts {
+				if err := leaf.VerifyHostname(host); err != nil {
+					mismatched = append(mismatched, fmt.Sprintf("%s (Secret %s)", host, tls.SecretName))
+				}
+			}
+		}
+		ref := report.ResourceRef{Kind: "Ingress", Namespace: ingress.Namespace, Name: ingress.Name}
+		if len(missing) > 0 {
+			out.objectf(report.Info, ref, "MissingTLSSecret", "Ingress %s namespace %s içinde TLS Secret'ları bulunamadı: %s", ingress.Name, ingress.Namespace, strings.Join(missing, ", "))
+		}
+		if len(mismatched) > 0 {
+			out.objectf(report.Info, ref, "HostMismatch", "Ingress %s namespace %s içinde TLS sertifikaları şu host'larla eşleşmiyor: %s", ingress.Name, ingress.Namespace, strings.Join(mismatched, ", "))
+		}
+	}
+	return nil
+}
+
+// reportCertificateExpiry, sertifikanın süresi dolmuşsa ya da
+// --cert-expiry-window içinde dolacaksa bir uyarı yazar. Sertifika tek bir
+// nesnede tutuluyorsa (ör. TLS Secret) bulgu ref ile o nesneye bağlanır.
+func (s *Suite) reportCertificateExpiry(out *findings, ref *report.ResourceRef, subject string, cert *x509.Certificate) {
+	remaining := time.Until(cert.NotAfter)
+	severity, reason, message := report.Critical, "CertificateExpired", fmt.Sprintf("%s %s tarihinde sona ermiş", subject, cert.NotAfter.Format(time.RFC3339))
+	if remaining > 0 {
+		if remaining >= s.opts.CertExpiryWindow {
+			return
+		}
+		severity, reason, message = report.Warning, "CertificateExpiring", fmt.Sprintf("%s %d gün içinde sona erecek (%s)", subject, int(remaining.Hours()/24), cert.NotAfter.Format(time.RFC3339))
+	}
+	if ref == nil {
+		out.addf(severity, "%s", message)
+		return
+	}
+	out.objectf(severity, *ref, reason, "%s", message)
+}
+
+// parseCertificates, PEM olarak kodlanmış verideki tüm sertifikaları çözer.
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// checkCertManager, cert-manager CRD'leri kuruluysa Ready=False olan
+// Certificate'leri, yenileme zamanı geçtiği halde başarısız olmaya devam eden
+// Certificate'leri ve takılmış ACME Order/Challenge nesnelerini raporlar.
+func (s *Suite) checkCertManager(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	certificates, found, err := servedResource(ctx, clientset, "cert-manager.io", "certificates", "v1")
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "cert-manager API sürümleri alınırken hata oluştu")
+	}
+	if !found {
+		return nil
+	}
+	list, err := listAll(ctx, s.opts, s.dynamic.Resource(certificates).Namespace("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("cert-manager Certificate'lerini", err)
+	}
+	for _, certificate := range list.Items {
+		for _, c := range unstructuredConditions(certificate.Object, "status", "conditions") {
+			if c.Type == "Ready" && c.Status != string(metav1.ConditionTrue) {
+				out.objectf(report.Info, report.ResourceRef{Kind: "Certificate", Namespace: certificate.GetNamespace(), Name: certificate.GetName()}, "NotReady", "Certificate %s namespace %s hazır değil (%s): %s", certificate.GetName(), certificate.GetNamespace(), c.Reason, c.Message)
+			}
+		}
+		renewal, _, _ := unstructured.NestedString(certificate.Object, "status", "renewalTime")
+		attempts, _, _ := unstructured.NestedInt64(certificate.Object, "status", "failedIssuanceAttempts")
+		if renewalTime, err := time.Parse(time.RFC3339, renewal); err == nil && time.Now().After(renewalTime) && attempts > 0 {
+			out.objectf(report.Info, report.ResourceRef{Kind: "Certificate", Namespace: certificate.GetNamespace(), Name: certificate.GetName()}, "RenewalFailing", "Certificate %s namespace %s yenileme zamanı %s geçti, %d yenileme denemesi başarısız oldu", certificate.GetName(), certificate.GetNamespace(), renewalTime.Format(time.RFC3339), attempts)
+		}
+	}
+
+	for _, resource := range []string{"orders", "challenges"} {
+		gvr, found, err := servedResource(ctx, clientset, "acme.cert-manager.io", resource, "v1")
+		if err != nil || !found {
+			continue
+		}
+		list, err := listAll(ctx, s.opts, s.dynamic.Resource(gvr).Namespace("").List, metav1.ListOptions{})
+		if err != nil {
+			out.infof("cert-manager %s listelenirken hata oluştu: %v", resource, err)
+			continue
+		}
+		for _, item := range list.Items {
+			state, _, _ := unstructured.NestedString(item.Object, "status", "state")
+			reason, _, _ := unstructured.NestedString(item.Object, "status", "reason")
+			age := time.Since(item.GetCreationTimestamp().Time)
+			switch state {
+			case "valid", "ready":
+			case "errored", "invalid", "expired":
+				out.objectf(report.Info, report.ResourceRef{Kind: item.GetKind(), Namespace: item.GetNamespace(), Name: item.GetName()}, "Failed", "ACME %s %s namespace %s başarısız (%s): %s", resource, item.GetName(), item.GetNamespace(), state, reason)
+			default:
+				if age > s.opts.CertManagerStuckThreshold {
+					out.objectf(report.Info, report.ResourceRef{Kind: item.GetKind(), Namespace: item.GetNamespace(), Name: item.GetName()}, "Stuck", "ACME %s %s namespace %s %s süredir %q durumunda: %s", resource, item.GetName(), item.GetNamespace(), age.Round(time.Second), state, reason)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkCertificateSigningRequests, --csr-pending-threshold süresinden uzun
+// süredir Pending kalan ya da Denied/Failed olan CSR'leri raporlar. Onaylanmayan
+// kubelet-serving CSR'leri, node metriklerinin ve log erişiminin aniden
+// bozulmasının sık rastlanan bir nedeni olduğu için ayrıca belirtilir.
+func (s *Suite) checkCertificateSigningRequests(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	csrs, err := listAll(ctx, s.opts, clientset.CertificatesV1().CertificateSigningRequests().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("CertificateSigningRequest'leri", err)
+	}
+	pendingKubeletServing := 0
+	for _, csr := range csrs.Items {
+		state := "Pending"
+		message := ""
+		for _, condition := range csr.Status.Conditions {
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch condition.Type {
+			case certificatesv1.CertificateApproved:
+				if state == "Pending" {
+					state = "Approved"
+				}
+			case certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+				state = string(condition.Type)
+				message = condition.Message
+			}
+		}
+		age := time.Since(csr.CreationTimestamp.Time)
+		switch state {
+		case "Pending":
+			if age < s.opts.CSRPendingThreshold {
+				continue
+			}
+			if csr.Spec.SignerName == certificatesv1.KubeletServingSignerName {
+				pendingKubeletServing++
+			}
+			out.objectf(report.Info, report.ResourceRef{Kind: "CertificateSigningRequest", Name: csr.Name}, "Pending", "CertificateSigningRequest %s (%s, %s) %s süredir onay bekliyor", csr.Name, csr.Spec.SignerName, csr.Spec.Username, age.Round(time.Second))
+		case string(certificatesv1.CertificateDenied), string(certificatesv1.CertificateFailed):
+			out.objectf(report.Info, report.ResourceRef{Kind: "CertificateSigningRequest", Name: csr.Name}, state, "CertificateSigningRequest %s (%s, %s) %s: %s", csr.Name, csr.Spec.SignerName, csr.Spec.Username, state, message)
+		}
+	}
+	if pendingKubeletServing > 0 {
+		out.warningf("%d kubelet-serving CSR onay bekliyor; metrics-server ve kubectl logs/exec bu node'larda çalışmayabilir", pendingKubeletServing)
+	}
+	return nil
+}
+
+// checkClusterCertificates, API server'ın sunduğu sertifikayı, kubeconfig'teki
+// istemci sertifikasını ve kube-system'deki iyi bilinen CA ConfigMap'lerini
+// inceleyerek --cert-expiry-window içinde sona erecek sertifikaları raporlar.
+func (s *Suite) checkClusterCertificates(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	if u, err := url.Parse(s.config.Host); err == nil && u.Scheme == "https" {
+		host := u.Host
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "443")
+		}
+		// Sertifika yalnızca incelendiği için doğrulama yapılmaz; güven zinciri
+		// istemci bağlantısında zaten kontrol edilir.
+		dialer := &tls.Dialer{NetDialer: &net.Dialer{Timeout: 5 * time.Second}, Config: &tls.Config{InsecureSkipVerify: true}}
+		conn, err := dialer.DialContext(ctx, "tcp", host)
+		if err != nil {
+			out.infof("API server sertifikası alınırken hata oluştu: %v", err)
+		} else {
+			certs := conn.(*tls.Conn).ConnectionState().PeerCertificates
+			conn.Close()
+			if len(certs) > 0 {
+				s.reportCertificateExpiry(out, nil, "API server sertifikası ("+certs[0].Subject.CommonName+")", certs[0])
+			}
+		}
+	}
+
+	clientCert := s.config.TLSClientConfig.CertData
+	if len(clientCert) == 0 && s.config.TLSClientConfig.CertFile != "" {
+		data, err := os.ReadFile(s.config.TLSClientConfig.CertFile)
+		if err != nil {
+			out.infof("kubeconfig istemci sertifikası okunurken hata oluştu: %v", err)
+		}
+		clientCert = data
+	}
+	if certs, err := parseCertificates(clientCert); err == nil && len(certs) > 0 {
+		s.reportCertificateExpiry(out, nil, "kubeconfig istemci sertifikası ("+certs[0].Subject.CommonName+")", certs[0])
+	}
+
+	wellKnown := map[string][]string{
+		"kube-root-ca.crt":                   {"ca.crt"},
+		"extension-apiserver-authentication": {"client-ca-file", "requestheader-client-ca-file"},
+	}
+	for name, keys := range wellKnown {
+		configMap, err := clientset.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			continue
+		}
+		for _, key := range keys {
+			certs, err := parseCertificates([]byte(configMap.Data[key]))
+			if err != nil {
+				out.infof("ConfigMap %s içindeki %s sertifikası okunamadı: %v", name, key, err)
+				continue
+			}
+			for _, cert := range certs {
+				s.reportCertificateExpiry(out, nil, fmt.Sprintf("ConfigMap %s içindeki %s sertifikası (%s)", name, key, cert.Subject.CommonName), cert)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+// Package checks, Kubernetes cluster'ı üzerinde çalışan yerleşik sağlık,
+// güvenlik ve kapasite kontrollerini içerir.
+package checks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Check, cluster üzerinde çalıştırılıp bulgu üreten tek bir kontroldür.
+type Check interface {
+	Name() string
+	Run(ctx context.Context, clientset kubernetes.Interface) ([]report.Finding, error)
+}
+
+// findings, bir kontrolün çalışması sırasında ürettiği bulguları toplar.
+type findings struct {
+	check string
+	list  []report.Finding
+}
+
+func (f *findings) addf(severity report.Severity, format string, args ...interface{}) {
+	f.list = append(f.list, report.Finding{Check: f.check, Severity: severity, Message: fmt.Sprintf(format, args...)})
+}
+
+// objectf, ref ile belirtilen nesneye ve reason nedenine bağlı bir bulgu ekler.
+func (f *findings) objectf(severity report.Severity, ref report.ResourceRef, reason string, format string, args ...interface{}) {
+	f.list = append(f.list, report.Finding{Check: f.check, Severity: severity, Resource: &ref, Reason: reason, Message: fmt.Sprintf(format, args...)})
+}
+
+func namespaceRef(name string) report.ResourceRef {
+	return report.ResourceRef{Kind: "Namespace", Name: name}
+}
+
+func podRef(pod *corev1.Pod) report.ResourceRef {
+	return report.ResourceRef{Kind: "Pod", Namespace: pod.Namespace, Name: pod.Name}
+}
+
+// errorf, err'i mesajı ve kodu (Reason) ile bulgu olarak ekler; kodu
+// olmayan hatalarda Reason boş kalır.
+func (f *findings) errorf(severity report.Severity, ref *report.ResourceRef, err error) {
+	f.list = append(f.list, report.Finding{Check: f.check, Severity: severity, Resource: ref, Reason: string(checkerrors.CodeOf(err)), Message: err.Error()})
+}
+
+func (f *findings) infof(format string, args ...interface{}) {
+	f.addf(report.Info, format, args...)
+}
+
+func (f *findings) warningf(format string, args ...interface{}) {
+	f.addf(report.Warning, format, args...)
+}
+
+func (f *findings) criticalf(format string, args ...interface{}) {
+	f.addf(report.Critical, format, args...)
+}
+
+// checkFunc, bir fonksiyonu adıyla birlikte Check olarak sunar.
+type checkFunc struct {
+	name string
+	run  func(ctx context.Context, clientset kubernetes.Interface, out *findings) error
+}
+
+func (c checkFunc) Name() string {
+	return c.name
+}
+
+func (c checkFunc) Run(ctx context.Context, clientset kubernetes.Interface) ([]report.Finding, error) {
+	out := &findings{check: c.name}
+	err := c.run(ctx, clientset, out)
+	return out.list, err
+}
+
+// Suite, yerleşik kontrolleri ortak seçenekler, ek istemciler ve döngüler
+// arasında saklanan durumla birlikte tutar. Aynı Suite'in kontrolleri her
+// döngüde yeniden çalıştırılmalıdır; süre takibi yapan kontroller önceki
+// döngülerde gördüklerini hatırlar. Döngüler arası durum alanlarının her biri
+// tek bir kontrole aittir; bu yüzden farklı kontroller paralel çalışabilir
+// ancak aynı kontrol aynı anda iki kez çalıştırılmamalıdır.
+type Suite struct {
+	opts     Options
+	dynamic  dynamic.Interface
+	metadata metadata.Interface
+	config   *rest.Config
+
+	// indexers, StartInformers ile doldurulan informer cache'lerini resource
+	// adına göre tutar; boşsa kontroller API'den listeler.
+	indexers map[string]cache.Indexer
+	// eventRings, StartEventWatch ile başlatılan event watch'larının halka
+	// tamponlarıdır; boşsa event'ler API'den listelenir.
+	eventRings []*eventRing
+
+	// nodeNotReadySince, node'ların NotReady durumuna geçtikleri zamanı
+	// döngüler arasında saklar.
+	nodeNotReadySince map[string]time.Time
+	// unboundPVSince, PV'lerin mevcut Available/Released durumlarında ilk
+	// görüldükleri zamanı döngüler arasında saklar.
+	unboundPVSince map[string]phaseSince
+	// apiLatencySamples, işlem adına göre son --api-latency-window ölçümü
+	// döngüler arasında saklar.
+	apiLatencySamples map[string][]apiLatencySample
+	// etcdLeaderChanges, etcd pod'larının döngüler arasında son okunan lider
+	// değişikliği sayacını saklar.
+	etcdLeaderChanges map[string]float64
+	// statefulSetClaimPrefixes, önceki döngüde görülen StatefulSet'lerin
+	// "namespace/<şablon>-<statefulset>" PVC öneklerini StatefulSet adına
+	// eşler; StatefulSet silindiğinde PVC'lerinin sahipsiz kaldığı bununla
+	// anlaşılır.
+	statefulSetClaimPrefixes map[string]string
+	// unschedulableHistory, her döngüde sayılan schedule edilemeyen pod
+	// sayılarını son --unschedulable-trend-cycles döngü için saklar.
+	unschedulableHistory []int
+	// trivy, --trivy-scan ile arka planda taranan image digest'lerinin
+	// sonuçlarını döngüler arasında saklar; ilk kullanımda oluşturulur.
+	trivy *trivyScanner
+	// results, Cached ile sarılan kontrollerin son başarılı bulgularını
+	// kontrol adına göre saklar. Kontroller paralel çalıştığından resultsMu
+	// ile korunur.
+	results   map[string]cachedResult
+	resultsMu sync.Mutex
+}
+
+// NewSuite, verilen seçeneklerle bir Suite oluşturur. dynamicClient CRD
+// tabanlı kontroller, metadataClient yalnızca nesne sayan kontroller, config
+// ise doğrudan API server'a bağlanan kontroller (sertifika ve saat sapması)
+// için kullanılır.
+func NewSuite(opts Options, dynamicClient dynamic.Interface, metadataClient metadata.Interface, config *rest.Config) *Suite {
+	return &Suite{
+		opts:              opts,
+		dynamic:           dynamicClient,
+		metadata:          metadataClient,
+		config:            config,
+		nodeNotReadySince: map[string]time.Time{},
+		unboundPVSince:    map[string]phaseSince{},
+		apiLatencySamples: map[string][]apiLatencySample{},
+		etcdLeaderChanges: map[string]float64{},
+		results:           map[string]cachedResult{},
+	}
+}
+
+// Checks, yerleşik kontrolleri çalıştırılma sıralarıyla döndürür.
+func (s *Suite) Checks() []Check {
+	return []Check{
+		checkFunc{"pods", s.checkPods},
+		checkFunc{"namespaces", s.checkNamespaces},
+		checkFunc{"nodes", s.checkNodes},
+		checkFunc{"node-not-ready-duration", s.checkNodeNotReadyDuration},
+		checkFunc{"node-capacity", s.checkNodeCapacity},
+		checkFunc{"cordoned-nodes", s.checkCordonedNodes},
+		checkFunc{"taints", s.checkTaints},
+		checkFunc{"version-skew", s.checkVersionSkew},
+		checkFunc{"node-inventory-drift", s.checkNodeInventoryDrift},
+		checkFunc{"node-leases", s.checkNodeLeases},
+		checkFunc{"cluster-autoscaler", s.checkClusterAutoscaler},
+		checkFunc{"spot-interruptions", s.checkSpotInterruptions},
+		checkFunc{"device-plugins", s.checkDevicePlugins},
+		checkFunc{"node-disk-usage", s.checkNodeDiskUsage},
+		checkFunc{"clock-skew", s.checkClockSkew},
+		checkFunc{"metrics-server", s.checkMetricsServer},
+		checkFunc{"unschedulable-trend", s.checkUnschedulableTrend},
+		checkFunc{"events", s.checkEvents},
+		checkFunc{"persistent-volume-claims", s.checkPersistentVolumeClaims},
+		checkFunc{"persistent-volumes", s.checkPersistentVolumes},
+		checkFunc{"unbound-persistent-volumes", s.checkUnboundPersistentVolumes},
+		checkFunc{"storage-classes", s.checkStorageClasses},
+		checkFunc{"volume-usage", s.checkVolumeUsage},
+		requireAPIGroups(checkFunc{"volume-snapshots", s.checkVolumeSnapshots}, "snapshot.storage.k8s.io"),
+		checkFunc{"csi-drivers", s.checkCSIDrivers},
+		shardByNamespace(checkFunc{"stuck-resizes", s.checkStuckResizes}),
+		shardByNamespace(checkFunc{"access-modes", s.checkAccessModes}),
+		checkFunc{"orphaned-statefulset-pvcs", s.checkOrphanedStatefulSetPVCs},
+		checkFunc{"volume-attachments", s.checkVolumeAttachments},
+		checkFunc{"host-path-volumes", s.checkHostPathVolumes},
+		checkFunc{"empty-dir-volumes", s.checkEmptyDirVolumes},
+		shardByNamespace(checkFunc{"storage-quotas", s.checkStorageQuotas}),
+		checkFunc{"cluster-admin-bindings", s.checkClusterAdminBindings},
+		checkFunc{"wildcard-rules", s.checkWildcardRules},
+		shardByNamespace(checkFunc{"service-account-automount", s.checkServiceAccountAutomount}),
+		shardByNamespace(checkFunc{"missing-secrets", s.checkMissingSecrets}),
+		shardByNamespace(checkFunc{"missing-config-maps", s.checkMissingConfigMaps}),
+		shardByNamespace(checkFunc{"unused-config", s.checkUnusedConfig}),
+		checkFunc{"pod-security-standards", s.checkPodSecurityStandards},
+		shardByNamespace(checkFunc{"host-namespaces", s.checkHostNamespaces}),
+		shardByNamespace(checkFunc{"dangerous-capabilities", s.checkDangerousCapabilities}),
+		shardByNamespace(checkFunc{"image-registries", s.checkImageRegistries}),
+		checkFunc{"vulnerabilities", s.checkVulnerabilities},
+		checkFunc{"seccomp-apparmor", s.checkSeccompAppArmor},
+		shardByNamespace(checkFunc{"legacy-service-account-tokens", s.checkLegacyServiceAccountTokens}),
+		checkFunc{"certificate-signing-requests", s.checkCertificateSigningRequests},
+		checkFunc{"cluster-certificates", s.checkClusterCertificates},
+		requireAPIGroups(checkFunc{"gatekeeper", s.checkGatekeeper}, "constraints.gatekeeper.sh"),
+		requireAPIGroups(checkFunc{"policy-reports", s.checkPolicyReports}, "wgpolicyk8s.io"),
+		shardByNamespace(checkFunc{"secret-env-vars", s.checkSecretEnvVars}),
+		checkFunc{"webhook-risks", s.checkWebhookRisks},
+		checkFunc{"deprecated-apis", s.checkDeprecatedAPIs},
+		checkFunc{"anonymous-access", s.checkAnonymousAccess},
+		checkFunc{"cis-benchmark", s.checkCISBenchmark},
+		shardByNamespace(checkFunc{"completed-pods", s.checkCompletedPods}),
+		shardByNamespace(checkFunc{"image-drift", s.checkImageDrift}),
+		checkFunc{"webhook-blocked-rollouts", s.checkWebhookBlockedRollouts},
+		checkFunc{"ephemeral-storage", s.checkEphemeralStorage},
+		checkFunc{"mirror-pods", s.checkMirrorPods},
+		checkFunc{"api-server-health", s.checkAPIServerHealth},
+		checkFunc{"api-latency", s.checkAPILatency},
+		checkFunc{"etcd", s.checkEtcd},
+		checkFunc{"cluster-version", s.checkClusterVersion},
+		checkFunc{"kube-system-addons", s.checkKubeSystemAddons},
+		checkFunc{"services", s.checkServices},
+		shardByNamespace(checkFunc{"endpoint-slices", s.checkEndpointSlices}),
+		shardByNamespace(checkFunc{"ingresses", s.checkIngresses}),
+		shardByNamespace(checkFunc{"tls-secrets", s.checkTLSSecrets}),
+		checkFunc{"coredns", s.checkCoreDNS},
+		checkFunc{"kube-proxy", s.checkKubeProxy},
+		checkFunc{"load-balancers", s.checkLoadBalancers},
+		checkFunc{"node-ports", s.checkNodePorts},
+		shardByNamespace(checkFunc{"statefulset-services", s.checkStatefulSetServices}),
+		checkFunc{"connectivity", s.checkConnectivity},
+		shardByNamespace(checkFunc{"external-name-services", s.checkExternalNameServices}),
+		checkFunc{"dual-stack", s.checkDualStack},
+		requireAPIGroups(checkFunc{"gateways", s.checkGateways}, "gateway.networking.k8s.io"),
+		checkFunc{"service-mesh", s.checkServiceMesh},
+		checkFunc{"orphaned-endpoints", s.checkOrphanedEndpoints},
+		requireAPIGroups(checkFunc{"cert-manager", s.checkCertManager}, "cert-manager.io"),
+	}
+}
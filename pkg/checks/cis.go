@@ -0,0 +1,310 @@
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// cisInventory, CIS kontrollerinin değerlendirildiği cluster verileridir.
+type cisInventory struct {
+	pods                []corev1.Pod
+	serviceAccounts     []corev1.ServiceAccount
+	clusterRoles        []rbacv1.ClusterRole
+	roles               []rbacv1.Role
+	clusterRoleBindings []rbacv1.ClusterRoleBinding
+	roleBindings        []rbacv1.RoleBinding
+	apiServerArgs       map[string]string
+
+	clusterAdminAllowedSubjects []string
+}
+
+// cisControl, CIS Kubernetes Benchmark'taki bir kontrolü tanımlar. evaluate,
+// kontrolün geçip geçmediğini ve başarısızlık durumunda kısa bir açıklama döndürür.
+// Değerlendirilemeyen kontroller için applicable false döner.
+type cisControl struct {
+	id       string
+	title    string
+	evaluate func(inv *cisInventory) (passed bool, applicable bool, detail string)
+}
+
+var cisControls = []cisControl{
+	{"1.2.1", "API server anonim isteklere izin vermemeli", func(inv *cisInventory) (bool, bool, string) {
+		if inv.apiServerArgs == nil {
+			return false, false, ""
+		}
+		return inv.apiServerArgs["anonymous-auth"] == "false", true, "anonymous-auth=false ayarlanmamış"
+	}},
+	{"1.2.7", "API server AlwaysAllow yetkilendirme modunu kullanmamalı", func(inv *cisInventory) (bool, bool, string) {
+		if inv.apiServerArgs == nil {
+			return false, false, ""
+		}
+		return !strings.Contains(inv.apiServerArgs["authorization-mode"], "AlwaysAllow"), true, "authorization-mode AlwaysAllow içeriyor"
+	}},
+	{"1.2.18", "API server profiling kapalı olmalı", func(inv *cisInventory) (bool, bool, string) {
+		if inv.apiServerArgs == nil {
+			return false, false, ""
+		}
+		return inv.apiServerArgs["profiling"] == "false", true, "profiling=false ayarlanmamış"
+	}},
+	{"1.2.19", "API server audit log yolu tanımlanmalı", func(inv *cisInventory) (bool, bool, string) {
+		if inv.apiServerArgs == nil {
+			return false, false, ""
+		}
+		return inv.apiServerArgs["audit-log-path"] != "", true, "audit-log-path ayarlanmamış"
+	}},
+	{"5.1.1", "cluster-admin rolü yalnızca gerektiğinde kullanılmalı", func(inv *cisInventory) (bool, bool, string) {
+		allowed := map[string]bool{}
+		for _, subject := range inv.clusterAdminAllowedSubjects {
+			allowed[subject] = true
+		}
+		var subjects []string
+		for _, binding := range inv.clusterRoleBindings {
+			if binding.RoleRef.Name != "cluster-admin" {
+				continue
+			}
+			for _, subject := range binding.Subjects {
+				if !allowed[subjectString(subject)] {
+					subjects = append(subjects, subjectString(subject))
+				}
+			}
+		}
+		return len(subjects) == 0, true, "izin listesi dışındaki subject'ler: " + strings.Join(subjects, ", ")
+	}},
+	{"5.1.3", "Role ve ClusterRole'lerde wildcard kullanımı en aza indirilmeli", func(inv *cisInventory) (bool, bool, string) {
+		count := 0
+		for _, role := range inv.clusterRoles {
+			if _, ok := firstWildcardRule(role.Rules); ok && !strings.HasPrefix(role.Name, "system:") && role.Name != "cluster-admin" {
+				count++
+			}
+		}
+		for _, role := range inv.roles {
+			if _, ok := firstWildcardRule(role.Rules); ok {
+				count++
+			}
+		}
+		return count == 0, true, fmt.Sprintf("%d rol wildcard kural içeriyor", count)
+	}},
+	{"5.1.5", "default ServiceAccount'lar token'ı otomatik bağlamamalı", func(inv *cisInventory) (bool, bool, string) {
+		var namespaces []string
+		for _, sa := range inv.serviceAccounts {
+			if sa.Name == "default" && (sa.AutomountServiceAccountToken == nil || *sa.AutomountServiceAccountToken) {
+				namespaces = append(namespaces, sa.Namespace)
+			}
+		}
+		return len(namespaces) == 0, true, fmt.Sprintf("%d namespace'te default ServiceAccount token bağlıyor", len(namespaces))
+	}},
+	{"5.1.x", "Anonim kullanıcılara RBAC yetkisi verilmemeli", func(inv *cisInventory) (bool, bool, string) {
+		count := 0
+		for _, binding := range inv.clusterRoleBindings {
+			for _, subject := range binding.Subjects {
+				if anonymousSubjects[subjectString(subject)] && !anonymousAllowedRoles[binding.RoleRef.Name] {
+					count++
+				}
+			}
+		}
+		for _, binding := range inv.roleBindings {
+			for _, subject := range binding.Subjects {
+				if anonymousSubjects[subjectString(subject)] {
+					count++
+				}
+			}
+		}
+		return count == 0, true, fmt.Sprintf("%d binding anonim kullanıcılara yetki veriyor", count)
+	}},
+	{"5.2.2", "Privileged container'lara izin verilmemeli", cisPodControl(func(pod *corev1.Pod, c *corev1.Container) bool {
+		return c.SecurityContext != nil && c.SecurityContext.Privileged != nil && *c.SecurityContext.Privileged
+	})},
+	{"5.2.3", "hostPID kullanımı en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, _ *corev1.Container) bool { return pod.Spec.HostPID })},
+	{"5.2.4", "hostIPC kullanımı en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, _ *corev1.Container) bool { return pod.Spec.HostIPC })},
+	{"5.2.5", "hostNetwork kullanımı en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, _ *corev1.Container) bool { return pod.Spec.HostNetwork })},
+	{"5.2.6", "allowPrivilegeEscalation en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, c *corev1.Container) bool {
+		return c.SecurityContext == nil || c.SecurityContext.AllowPrivilegeEscalation == nil || *c.SecurityContext.AllowPrivilegeEscalation
+	})},
+	{"5.2.7", "Root container'lar en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, c *corev1.Container) bool {
+		podNonRoot := pod.Spec.SecurityContext != nil && pod.Spec.SecurityContext.RunAsNonRoot != nil && *pod.Spec.SecurityContext.RunAsNonRoot
+		containerNonRoot := c.SecurityContext != nil && c.SecurityContext.RunAsNonRoot != nil && *c.SecurityContext.RunAsNonRoot
+		return !podNonRoot && !containerNonRoot
+	})},
+	{"5.2.9", "Ek yetki (capability) tanımlayan container'lar en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, c *corev1.Container) bool {
+		return c.SecurityContext != nil && c.SecurityContext.Capabilities != nil && len(c.SecurityContext.Capabilities.Add) > 0
+	})},
+	{"5.2.12", "hostPath volume kullanımı en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, _ *corev1.Container) bool {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.HostPath != nil {
+				return true
+			}
+		}
+		return false
+	})},
+	{"5.2.13", "hostPort kullanan container'lar en aza indirilmeli", cisPodControl(func(pod *corev1.Pod, c *corev1.Container) bool {
+		for _, port := range c.Ports {
+			if port.HostPort != 0 {
+				return true
+			}
+		}
+		return false
+	})},
+	{"5.4.1", "Secret'lar ortam değişkeni yerine dosya olarak kullanılmalı", cisPodControl(func(pod *corev1.Pod, c *corev1.Container) bool {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.SecretRef != nil {
+				return true
+			}
+		}
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				return true
+			}
+		}
+		return false
+	})},
+	{"5.7.4", "default namespace kullanılmamalı", func(inv *cisInventory) (bool, bool, string) {
+		count := 0
+		for _, pod := range inv.pods {
+			if pod.Namespace == metav1.NamespaceDefault {
+				count++
+			}
+		}
+		return count == 0, true, fmt.Sprintf("default namespace'te %d pod var", count)
+	}},
+}
+
+// cisPodControl, kube-system dışındaki pod'larda ya da container'larında
+// violates koşulunu sağlayan pod olmadığında geçen bir kontrol oluşturur.
+func cisPodControl(violates func(pod *corev1.Pod, container *corev1.Container) bool) func(inv *cisInventory) (bool, bool, string) {
+	return func(inv *cisInventory) (bool, bool, string) {
+		var offenders []string
+		for i := range inv.pods {
+			pod := &inv.pods[i]
+			if pod.Namespace == metav1.NamespaceSystem {
+				continue
+			}
+			for _, container := range allContainers(&pod.Spec) {
+				if violates(pod, &container) {
+					offenders = append(offenders, pod.Namespace+"/"+pod.Name)
+					break
+				}
+			}
+		}
+		detail := fmt.Sprintf("%d pod ihlal ediyor", len(offenders))
+		if len(offenders) > 0 && len(offenders) <= 5 {
+			detail += ": " + strings.Join(offenders, ", ")
+		}
+		return len(offenders) == 0, true, detail
+	}
+}
+
+// checkCISBenchmark, CIS Kubernetes Benchmark'ın API üzerinden incelenebilen
+// alt kümesini (RBAC, pod güvenliği, Secret kullanımı, anonim erişim ve
+// kubeadm API server ayarları) değerlendirir; her kontrol için geçti/kaldı
+// sonucunu ve genel uyum yüzdesini yazar.
+func (s *Suite) checkCISBenchmark(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	inv, err := s.collectCISInventory(ctx, clientset)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "CIS kontrolleri için cluster verileri alınırken hata oluştu")
+	}
+	inv.clusterAdminAllowedSubjects = splitList(s.opts.ClusterAdminAllowedSubjects)
+	passed, evaluated := 0, 0
+	for _, control := range cisControls {
+		ok, applicable, detail := control.evaluate(inv)
+		if !applicable {
+			out.infof("CIS %s [ATLANDI] %s", control.id, control.title)
+			continue
+		}
+		evaluated++
+		if ok {
+			passed++
+			out.infof("CIS %s [GEÇTİ] %s", control.id, control.title)
+		} else {
+			out.infof("CIS %s [KALDI] %s: %s", control.id, control.title, detail)
+		}
+	}
+	if evaluated > 0 {
+		out.infof("CIS uyumu: %%%.0f (%d/%d kontrol)", float64(passed)/float64(evaluated)*100, passed, evaluated)
+	}
+	return nil
+}
+
+func (s *Suite) collectCISInventory(ctx context.Context, clientset kubernetes.Interface) (*cisInventory, error) {
+	inv := &cisInventory{}
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	inv.pods = pods.Items
+	serviceAccounts, err := s.listServiceAccounts(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	inv.serviceAccounts = serviceAccounts.Items
+	clusterRoles, err := s.listClusterRoles(ctx, clientset)
+	if err != nil {
+		return nil, err
+	}
+	inv.clusterRoles = clusterRoles.Items
+	roles, err := s.listRoles(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	inv.roles = roles.Items
+	clusterRoleBindings, err := s.listClusterRoleBindings(ctx, clientset)
+	if err != nil {
+		return nil, err
+	}
+	inv.clusterRoleBindings = clusterRoleBindings.Items
+	roleBindings, err := s.listRoleBindings(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	inv.roleBindings = roleBindings.Items
+	inv.apiServerArgs = kubeadmAPIServerArgs(ctx, clientset)
+	return inv, nil
+}
+
+// kubeadmAPIServerArgs, kube-system/kubeadm-config ConfigMap'indeki
+// ClusterConfiguration'dan API server extraArgs değerlerini okur. kubeadm ile
+// kurulmamış cluster'larda nil döner.
+func kubeadmAPIServerArgs(ctx context.Context, clientset kubernetes.Interface) map[string]string {
+	configMap, err := clientset.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, "kubeadm-config", metav1.GetOptions{})
+	if err != nil {
+		return nil
+	}
+	data, err := yaml.YAMLToJSON([]byte(configMap.Data["ClusterConfiguration"]))
+	if err != nil {
+		return nil
+	}
+	var config struct {
+		APIServer struct {
+			ExtraArgs json.RawMessage `json:"extraArgs"`
+		} `json:"apiServer"`
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil
+	}
+	args := map[string]string{}
+	if len(config.APIServer.ExtraArgs) == 0 {
+		return args
+	}
+	// kubeadm v1beta3 extraArgs'ı map, v1beta4 ise ad/değer listesi olarak tutar.
+	if err := json.Unmarshal(config.APIServer.ExtraArgs, &args); err == nil {
+		return args
+	}
+	var list []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(config.APIServer.ExtraArgs, &list); err == nil {
+		for _, arg := range list {
+			args[arg.Name] = arg.Value
+		}
+	}
+	return args
+}
@@ -0,0 +1,145 @@
+package checks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+func (s *Suite) checkPods(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	count, err := s.countObjects(ctx, "pods", corev1.SchemeGroupVersion.WithResource("pods"))
+	if err != nil {
+		return checkerrors.ListFailed("Pod'ları", err)
+	}
+	out.infof("Cluster'da %d pod var", count)
+	return nil
+}
+
+func (s *Suite) checkNamespaces(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	count, err := s.countObjects(ctx, "namespaces", corev1.SchemeGroupVersion.WithResource("namespaces"))
+	if err != nil {
+		return checkerrors.ListFailed("Namespace'leri", err)
+	}
+	out.infof("Cluster'da %d namespace var", count)
+	return nil
+}
+
+func (s *Suite) checkNodes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	if len(nodes.Items) == 0 {
+		out.errorf(report.Info, nil, checkerrors.NoNodesInKubernetes{})
+	} else {
+		out.infof("Cluster'da %d node var", len(nodes.Items))
+	}
+
+	// Ready dışındaki tüm koşullar (MemoryPressure, DiskPressure, PIDPressure,
+	// NetworkUnavailable ve node-problem-detector koşulları) False olmalıdır.
+	// Sağlıksız koşullar uyarı olarak, Ready=False ise kritik olarak raporlanır.
+	for _, node := range nodes.Items {
+		for _, condition := range node.Status.Conditions {
+			healthy := condition.Status == corev1.ConditionFalse
+			severity := report.Warning
+			if condition.Type == corev1.NodeReady {
+				healthy = condition.Status == corev1.ConditionTrue
+				if condition.Status == corev1.ConditionFalse {
+					severity = report.Critical
+				}
+			}
+			if healthy {
+				continue
+			}
+			duration := time.Since(condition.LastTransitionTime.Time).Round(time.Second)
+			out.objectf(severity, nodeRef(node.Name), string(condition.Type), "Node %s %s koşulu %s süredir %s: %s", node.Name, condition.Type, duration, condition.Status, condition.Message)
+		}
+	}
+	return nil
+}
+
+func (s *Suite) checkEvents(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	// Event watch'ları çalışıyorsa cluster genelindeki tüm Event'leri
+	// listelemek yerine tampondaki Warning ve izlenen nedenli event'ler sayılır.
+	if s.eventRings != nil {
+		events, err := s.listEvents(ctx, clientset, "")
+		if err != nil {
+			return checkerrors.ListFailed("Event'leri", err)
+		}
+		out.infof("Event tamponunda %d Warning ve izlenen nedenli event var", len(events))
+		return nil
+	}
+	count, err := s.countObjects(ctx, "events", corev1.SchemeGroupVersion.WithResource("events"))
+	if err != nil {
+		return checkerrors.ListFailed("Event'leri", err)
+	}
+	out.infof("Son 1 saatte %d event var", count)
+	return nil
+}
+
+func (s *Suite) checkPersistentVolumeClaims(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pvcs, err := s.listPersistentVolumeClaims(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("PersistentVolumeClaim'leri", err)
+	}
+	out.infof("Cluster'da %d PersistentVolumeClaim var", len(pvcs.Items))
+
+	var pending *pendingClaimLookup
+	for _, pvc := range pvcs.Items {
+		expectedPhase := corev1.ClaimBound
+		if pvc.Status.Phase != expectedPhase {
+			ref := report.ResourceRef{Kind: "PersistentVolumeClaim", Namespace: pvc.Namespace, Name: pvc.Name}
+			out.errorf(report.Info, &ref, checkerrors.PersistentVolumeClaimNotInStatus{Namespace: pvc.Namespace, Name: pvc.Name, Phase: pvc.Status.Phase, Expected: expectedPhase})
+			if pvc.Status.Phase == corev1.ClaimPending {
+				if pending == nil {
+					pending = s.newPendingClaimLookup(ctx, clientset)
+				}
+				out.infof("  Neden: %s", pending.reason(&pvc))
+			}
+		}
+	}
+	return nil
+}
+
+// SpecificPod, verilen namespace'teki tek bir pod'un durumunu raporlayan bir Check döndürür.
+func SpecificPod(namespace, podName string) Check {
+	return checkFunc{"specific-pod", func(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+		return checkSpecificPod(ctx, clientset, namespace, podName, out)
+	}}
+}
+
+func checkSpecificPod(ctx context.Context, clientset kubernetes.Interface, namespace, podName string, out *findings) error {
+	pod, err := clientset.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	ref := report.ResourceRef{Kind: "Pod", Namespace: namespace, Name: podName}
+	if errors.IsNotFound(err) {
+		out.objectf(report.Info, ref, string(metav1.StatusReasonNotFound), "Pod %s namespace %s içinde bulunamadı", podName, namespace)
+	} else if statusError, isStatus := err.(*errors.StatusError); isStatus {
+		out.objectf(report.Info, ref, string(statusError.ErrStatus.Reason), "Pod %s namespace %s içinde alınan hata: %v", podName, namespace, statusError.ErrStatus.Message)
+	} else if err != nil {
+		out.infof("Pod bilgisi alınırken hata oluştu: %v", err)
+	} else {
+		out.infof("Pod %s namespace %s içinde bulundu", podName, namespace)
+		out.infof("Pod durumu: %s", pod.Status.Phase)
+		out.infof("Pod IP: %s", pod.Status.PodIP)
+		out.infof("Node: %s", pod.Spec.NodeName)
+	}
+	return nil
+}
+
+// splitList, virgülle ayrılmış bir flag değerini boş elemanları atarak böler.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
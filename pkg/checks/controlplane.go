@@ -0,0 +1,545 @@
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// checkMirrorPods, kubeadm gibi kurulumlarda static pod olarak çalışan
+// control-plane bileşenlerinin her control-plane node'unda mevcut ve hazır
+// olduğunu doğrular. Eksik ya da hazır olmayan mirror pod'lar kritik olarak raporlanır.
+func (s *Suite) checkMirrorPods(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Control-plane node'larını", err)
+	}
+	var controlPlaneNodes []string
+	for _, node := range nodes.Items {
+		if isControlPlaneNode(&node) {
+			controlPlaneNodes = append(controlPlaneNodes, node.Name)
+		}
+	}
+	if len(controlPlaneNodes) == 0 {
+		// Yönetilen cluster'larda control-plane node'ları görünmez.
+		return nil
+	}
+
+	pods, err := s.listPods(ctx, clientset, metav1.NamespaceSystem)
+	if err != nil {
+		return checkerrors.ListFailed("kube-system pod'larını", err)
+	}
+	mirrorPods := map[string]*corev1.Pod{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; !ok {
+			continue
+		}
+		component := pod.Labels["component"]
+		if component == "" {
+			component = strings.TrimSuffix(pod.Name, "-"+pod.Spec.NodeName)
+		}
+		mirrorPods[pod.Spec.NodeName+"/"+component] = pod
+	}
+
+	for _, nodeName := range controlPlaneNodes {
+		var missing []string
+		for _, component := range strings.Split(s.opts.ControlPlaneComponents, ",") {
+			component = strings.TrimSpace(component)
+			if component == "" {
+				continue
+			}
+			pod, ok := mirrorPods[nodeName+"/"+component]
+			if !ok {
+				missing = append(missing, component)
+			} else if !isPodReady(pod) {
+				out.objectf(report.Critical, podRef(pod), "MirrorPodNotReady", "%s mirror pod'u %s node %s üzerinde hazır değil (durum: %s)", component, pod.Name, nodeName, pod.Status.Phase)
+			}
+		}
+		if len(missing) > 0 {
+			out.objectf(report.Critical, nodeRef(nodeName), "MirrorPodMissing", "Control-plane node %s üzerinde şu mirror pod'lar bulunamadı: %s", nodeName, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// isControlPlaneNode, node'un control-plane rolü etiketini taşıyıp taşımadığını döndürür.
+func isControlPlaneNode(node *corev1.Node) bool {
+	_, controlPlane := node.Labels["node-role.kubernetes.io/control-plane"]
+	_, master := node.Labels["node-role.kubernetes.io/master"]
+	return controlPlane || master
+}
+
+// isPodReady, pod'un Ready koşulunun True olup olmadığını döndürür.
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// removedAPI, kullanımdan kaldırılmış bir API sürümünü ve kaldırıldığı minor sürümü tanımlar.
+type removedAPI struct {
+	groupVersion string
+	kinds        []string
+	removedIn    int
+	replacement  string
+}
+
+// removedAPIs, Kubernetes sürümlerinde kaldırılan ya da kaldırılacak API sürümleridir.
+var removedAPIs = []removedAPI{
+	{"extensions/v1beta1", []string{"Ingress", "Deployment", "DaemonSet", "ReplicaSet", "NetworkPolicy"}, 22, "networking.k8s.io/v1 / apps/v1"},
+	{"apps/v1beta1", nil, 16, "apps/v1"},
+	{"apps/v1beta2", nil, 16, "apps/v1"},
+	{"networking.k8s.io/v1beta1", []string{"Ingress", "IngressClass"}, 22, "networking.k8s.io/v1"},
+	{"rbac.authorization.k8s.io/v1beta1", nil, 22, "rbac.authorization.k8s.io/v1"},
+	{"admissionregistration.k8s.io/v1beta1", nil, 22, "admissionregistration.k8s.io/v1"},
+	{"apiextensions.k8s.io/v1beta1", nil, 22, "apiextensions.k8s.io/v1"},
+	{"certificates.k8s.io/v1beta1", nil, 22, "certificates.k8s.io/v1"},
+	{"coordination.k8s.io/v1beta1", nil, 22, "coordination.k8s.io/v1"},
+	{"scheduling.k8s.io/v1beta1", nil, 22, "scheduling.k8s.io/v1"},
+	{"batch/v1beta1", []string{"CronJob"}, 25, "batch/v1"},
+	{"policy/v1beta1", []string{"PodDisruptionBudget", "PodSecurityPolicy"}, 25, "policy/v1"},
+	{"discovery.k8s.io/v1beta1", []string{"EndpointSlice"}, 25, "discovery.k8s.io/v1"},
+	{"events.k8s.io/v1beta1", []string{"Event"}, 25, "events.k8s.io/v1"},
+	{"autoscaling/v2beta1", []string{"HorizontalPodAutoscaler"}, 25, "autoscaling/v2"},
+	{"autoscaling/v2beta2", []string{"HorizontalPodAutoscaler"}, 26, "autoscaling/v2"},
+	{"flowcontrol.apiserver.k8s.io/v1beta1", nil, 26, "flowcontrol.apiserver.k8s.io/v1"},
+	{"storage.k8s.io/v1beta1", []string{"CSIStorageCapacity"}, 27, "storage.k8s.io/v1"},
+	{"flowcontrol.apiserver.k8s.io/v1beta2", nil, 29, "flowcontrol.apiserver.k8s.io/v1"},
+	{"flowcontrol.apiserver.k8s.io/v1beta3", nil, 32, "flowcontrol.apiserver.k8s.io/v1"},
+}
+
+// deprecatedAPIScanResources, kullanımdan kaldırılmış API izleri aranan kaynaklardır.
+var deprecatedAPIScanResources = []schema.GroupVersionResource{
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "apps", Version: "v1", Resource: "statefulsets"},
+	{Group: "apps", Version: "v1", Resource: "daemonsets"},
+	{Group: "batch", Version: "v1", Resource: "cronjobs"},
+	{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"},
+	{Group: "networking.k8s.io", Version: "v1", Resource: "networkpolicies"},
+	{Group: "policy", Version: "v1", Resource: "poddisruptionbudgets"},
+	{Group: "autoscaling", Version: "v2", Resource: "horizontalpodautoscalers"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "roles"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles"},
+	{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations"},
+	{Group: "admissionregistration.k8s.io", Version: "v1", Resource: "mutatingwebhookconfigurations"},
+	{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"},
+}
+
+// checkDeprecatedAPIs, canlı nesnelerin managedFields kayıtlarında ve
+// kubectl last-applied annotation'ında geçen API sürümlerini tarar;
+// hedef sürüme kadar kaldırılan API'ler üzerinden yönetilen nesneleri,
+// yükseltmeden önce taşınmaları gerektiği için raporlar.
+func (s *Suite) checkDeprecatedAPIs(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	serverVersion, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Sunucu sürümü alınırken hata oluştu")
+	}
+	current, err := minorVersion(serverVersion.Minor)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Sunucu sürümü %q çözülemedi", serverVersion.GitVersion)
+	}
+	target := current + 2
+	if s.opts.TargetKubernetesVersion != "" {
+		if _, err := fmt.Sscanf(s.opts.TargetKubernetesVersion, "1.%d", &target); err != nil {
+			return checkerrors.Wrap(checkerrors.CodeInvalidConfig, err, "Geçersiz --target-kubernetes-version değeri %q", s.opts.TargetKubernetesVersion)
+		}
+	}
+	removed := map[string]removedAPI{}
+	for _, api := range removedAPIs {
+		if api.removedIn <= target {
+			removed[api.groupVersion] = api
+		}
+	}
+
+	for _, gvr := range deprecatedAPIScanResources {
+		list, err := listAll(ctx, s.opts, s.dynamic.Resource(gvr).List, metav1.ListOptions{})
+		if err != nil {
+			continue
+		}
+		for _, item := range list.Items {
+			versions := map[string]bool{}
+			for _, entry := range item.GetManagedFields() {
+				versions[entry.APIVersion] = true
+			}
+			if lastApplied, ok := item.GetAnnotations()[corev1.LastAppliedConfigAnnotation]; ok {
+				var applied struct {
+					APIVersion string `json:"apiVersion"`
+				}
+				if json.Unmarshal([]byte(lastApplied), &applied) == nil {
+					versions[applied.APIVersion] = true
+				}
+			}
+			for version := range versions {
+				api, ok := removed[version]
+				if !ok || (len(api.kinds) > 0 && !containsString(api.kinds, item.GetKind())) {
+					continue
+				}
+				state := "kaldırılacak"
+				if api.removedIn <= current {
+					state = "kaldırıldı"
+				}
+				out.infof("%s %s %s üzerinden yönetiliyor; bu API 1.%d sürümünde %s, %s kullanılmalı", item.GetKind(), namespacedName(item.GetNamespace(), item.GetName()), version, api.removedIn, state, api.replacement)
+			}
+		}
+	}
+	return nil
+}
+
+// minorVersion, "27" ya da "27+" biçimindeki minor sürüm değerini sayıya çevirir.
+func minorVersion(minor string) (int, error) {
+	return strconv.Atoi(strings.TrimSuffix(minor, "+"))
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAPIServerHealth, API server'ın /livez, /readyz ve /version uç
+// noktalarını sorgular. Başarısız health uç noktaları kritik olarak, verbose
+// çıktıda başarısız görünen readyz alt kontrolleri ise tek tek raporlanır.
+func (s *Suite) checkAPIServerHealth(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	restClient := clientset.Discovery().RESTClient()
+	for _, path := range []string{"/livez", "/readyz"} {
+		body, err := restClient.Get().AbsPath(path).Param("verbose", "true").DoRaw(ctx)
+		if err == nil {
+			continue
+		}
+		out.criticalf("API server %s kontrolü başarısız: %v", path, err)
+		for _, line := range strings.Split(string(body), "\n") {
+			if strings.HasPrefix(line, "[-]") {
+				out.infof("  %s", strings.TrimPrefix(line, "[-]"))
+			}
+		}
+	}
+	serverVersion, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		out.criticalf("API server /version isteği başarısız: %v", err)
+		return nil
+	}
+	out.infof("API server sürümü: %s (%s)", serverVersion.GitVersion, serverVersion.Platform)
+	return nil
+}
+
+// apiLatencySample, tek bir API çağrısının süresini ve başarısını tutar.
+type apiLatencySample struct {
+	duration time.Duration
+	failed   bool
+}
+
+// checkAPILatency, temsilî API çağrılarının (tekil get, küçük list ve tüm
+// cluster'daki pod'ları kapsayan büyük list) süresini her döngüde ölçer ve
+// son ölçümler üzerinden p50/p95 gecikmesini ve hata oranını raporlar.
+// p95 gecikmesi --api-latency-threshold değerini aşan işlemler uyarılır.
+func (s *Suite) checkAPILatency(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	operations := []struct {
+		name string
+		call func() error
+	}{
+		{"get namespace", func() error {
+			_, err := clientset.CoreV1().Namespaces().Get(ctx, metav1.NamespaceDefault, metav1.GetOptions{})
+			return err
+		}},
+		{"list namespaces", func() error {
+			_, err := s.listNamespaces(ctx, clientset)
+			return err
+		}},
+		{"list pods", func() error {
+			_, err := s.listPods(ctx, clientset, "")
+			return err
+		}},
+	}
+	for _, operation := range operations {
+		start := time.Now()
+		err := operation.call()
+		samples := append(s.apiLatencySamples[operation.name], apiLatencySample{duration: time.Since(start), failed: err != nil})
+		if len(samples) > s.opts.APILatencyWindow {
+			samples = samples[len(samples)-s.opts.APILatencyWindow:]
+		}
+		s.apiLatencySamples[operation.name] = samples
+
+		durations := make([]time.Duration, 0, len(samples))
+		failures := 0
+		for _, sample := range samples {
+			durations = append(durations, sample.duration)
+			if sample.failed {
+				failures++
+			}
+		}
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		p50 := durations[(len(durations)-1)*50/100]
+		p95 := durations[(len(durations)-1)*95/100]
+		errorRate := float64(failures) / float64(len(samples)) * 100
+		severity := report.Info
+		if p95 > s.opts.APILatencyThreshold {
+			severity = report.Warning
+		}
+		out.addf(severity, "API %s gecikmesi: p50 %s, p95 %s, hata oranı %%%.0f (%d ölçüm)", operation.name, p50.Round(time.Millisecond), p95.Round(time.Millisecond), errorRate, len(samples))
+	}
+	return nil
+}
+
+// checkEtcd, etcd'nin kube-system içinde pod olarak çalıştığı cluster'larda
+// etcd üye pod'larının sağlığını, veritabanı boyutunun kotaya oranını ve
+// son döngüden bu yana yaşanan lider değişikliklerini raporlar. Veritabanı
+// boyutu API server metriklerinden, lider değişiklikleri ise pod proxy
+// üzerinden etcd metriklerinden okunur.
+func (s *Suite) checkEtcd(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods(metav1.NamespaceSystem).List, metav1.ListOptions{LabelSelector: "component=etcd"})
+	if err != nil {
+		return checkerrors.ListFailed("etcd pod'larını", err)
+	}
+	if len(pods.Items) == 0 {
+		// Yönetilen cluster'larda etcd görünmez.
+		return nil
+	}
+	out.infof("etcd:")
+	quota := s.opts.EtcdQuotaBytes
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		restarts := int32(0)
+		for _, status := range pod.Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+		if !isPodReady(pod) {
+			out.addf(report.Critical, "  etcd üyesi %s (node %s) hazır değil (durum: %s)", pod.Name, pod.Spec.NodeName, pod.Status.Phase)
+		} else {
+			out.infof("  etcd üyesi %s (node %s) hazır, %d yeniden başlatma", pod.Name, pod.Spec.NodeName, restarts)
+		}
+		for _, container := range pod.Spec.Containers {
+			for _, arg := range append(container.Command, container.Args...) {
+				if value, ok := strings.CutPrefix(arg, "--quota-backend-bytes="); ok {
+					if parsed, err := strconv.ParseInt(value, 10, 64); err == nil {
+						quota = parsed
+					}
+				}
+			}
+		}
+
+		metrics, err := clientset.CoreV1().RESTClient().Get().
+			Resource("pods").Namespace(pod.Namespace).Name(fmt.Sprintf("http:%s:%d", pod.Name, s.opts.EtcdMetricsPort)).SubResource("proxy").Suffix("metrics").
+			DoRaw(ctx)
+		if err != nil {
+			continue
+		}
+		if changes, ok := prometheusMetric(string(metrics), "etcd_server_leader_changes_seen_total"); ok {
+			if previous, seen := s.etcdLeaderChanges[pod.Name]; seen && changes > previous {
+				out.addf(report.Warning, "  etcd üyesi %s son döngüden bu yana %.0f lider değişikliği gördü", pod.Name, changes-previous)
+			}
+			s.etcdLeaderChanges[pod.Name] = changes
+		}
+	}
+
+	metrics, err := clientset.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "  API server metrikleri alınırken hata oluştu")
+	}
+	size, ok := prometheusMetric(string(metrics), "apiserver_storage_db_total_size_in_bytes")
+	if !ok {
+		size, ok = prometheusMetric(string(metrics), "apiserver_storage_size_bytes")
+	}
+	if !ok || quota <= 0 {
+		return nil
+	}
+	percent := size / float64(quota) * 100
+	severity := report.Info
+	if percent >= s.opts.EtcdDBThreshold {
+		severity = report.Warning
+	}
+	out.addf(severity, "  etcd veritabanı boyutu %.0f MiB, kotanın %%%.0f'i (%.0f MiB)", size/(1024*1024), percent, float64(quota)/(1024*1024))
+	return nil
+}
+
+// prometheusMetric, Prometheus metin biçimindeki metrik çıktısında verilen
+// adlı serilerin en büyük değerini döndürür.
+func prometheusMetric(metrics, name string) (float64, bool) {
+	var value float64
+	found := false
+	for _, line := range strings.Split(metrics, "\n") {
+		if !strings.HasPrefix(line, name) || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rest := line[len(name):]
+		if !strings.HasPrefix(rest, " ") && !strings.HasPrefix(rest, "{") {
+			continue
+		}
+		fields := strings.Fields(rest[strings.LastIndex(rest, "}")+1:])
+		if len(fields) == 0 {
+			continue
+		}
+		parsed, err := strconv.ParseFloat(fields[0], 64)
+		if err != nil {
+			continue
+		}
+		if !found || parsed > value {
+			value = parsed
+		}
+		found = true
+	}
+	return value, found
+}
+
+// kubernetesEndOfLife, upstream Kubernetes minor sürümlerinin destek bitiş
+// tarihleridir. Yönetilen servislerin (EKS, GKE, AKS) uzatılmış destek
+// takvimleri farklı olabilir.
+var kubernetesEndOfLife = map[int]string{
+	24: "2023-07-28",
+	25: "2023-10-28",
+	26: "2024-02-28",
+	27: "2024-06-28",
+	28: "2024-10-28",
+	29: "2025-02-28",
+	30: "2025-06-28",
+	31: "2025-10-28",
+	32: "2026-02-28",
+	33: "2026-06-28",
+	34: "2026-10-27",
+	35: "2027-02-28",
+}
+
+// checkClusterVersion, cluster'ın Kubernetes minor sürümünü raporlar ve
+// upstream destek bitiş tarihi geçmişse kritik, --eol-warning-window içinde
+// ise uyarı verir. --environment ve --environment-target-versions
+// verildiğinde cluster sürümü ortamın hedef sürümüyle de karşılaştırılır.
+func (s *Suite) checkClusterVersion(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	serverVersion, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Sunucu sürümü alınırken hata oluştu")
+	}
+	minor, err := minorVersion(serverVersion.Minor)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Sunucu sürümü %q çözülemedi", serverVersion.GitVersion)
+	}
+	out.infof("Cluster Kubernetes sürümü: 1.%d (%s)", minor, serverVersion.GitVersion)
+	if date, ok := kubernetesEndOfLife[minor]; ok {
+		endOfLife, _ := time.Parse("2006-01-02", date)
+		remaining := time.Until(endOfLife)
+		switch {
+		case remaining < 0:
+			out.criticalf("Kubernetes 1.%d upstream desteği %s tarihinde sona erdi", minor, date)
+		case remaining < s.opts.EOLWarningWindow:
+			out.warningf("Kubernetes 1.%d upstream desteği %d gün içinde (%s) sona eriyor", minor, int(remaining.Hours()/24), date)
+		}
+	}
+
+	if s.opts.Environment == "" {
+		return nil
+	}
+	for _, entry := range splitList(s.opts.EnvironmentTargets) {
+		name, target, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(name) != s.opts.Environment {
+			continue
+		}
+		var targetMinor int
+		if _, err := fmt.Sscanf(strings.TrimSpace(target), "1.%d", &targetMinor); err != nil {
+			return checkerrors.Wrap(checkerrors.CodeInvalidConfig, err, "Geçersiz hedef sürüm %q", entry)
+		}
+		if minor < targetMinor {
+			out.warningf("%s ortamı hedef sürüm 1.%d, cluster ise 1.%d sürümünde", s.opts.Environment, targetMinor, minor)
+		}
+	}
+	return nil
+}
+
+// addonWorkload, eklenti kontrol listesiyle eşleştirilen bir Deployment ya da DaemonSet'tir.
+type addonWorkload struct {
+	kind     string
+	name     string
+	ready    int32
+	desired  int32
+	selector *metav1.LabelSelector
+}
+
+// checkKubeSystemAddons, --kube-system-addons kontrol listesindeki her
+// eklentinin kube-system namespace'inde bir Deployment ya da DaemonSet olarak
+// bulunduğunu, tüm replikalarının hazır olduğunu ve pod'larının
+// CrashLoopBackOff durumunda olmadığını doğrular.
+func (s *Suite) checkKubeSystemAddons(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	workloads := map[string]addonWorkload{}
+	deployments, err := s.listDeployments(ctx, clientset, metav1.NamespaceSystem)
+	if err != nil {
+		return checkerrors.ListFailed("kube-system Deployment'larını", err)
+	}
+	for _, deployment := range deployments.Items {
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		workloads[deployment.Name] = addonWorkload{"Deployment", deployment.Name, deployment.Status.ReadyReplicas, desired, deployment.Spec.Selector}
+	}
+	daemonSets, err := s.listDaemonSets(ctx, clientset, metav1.NamespaceSystem)
+	if err != nil {
+		return checkerrors.ListFailed("kube-system DaemonSet'lerini", err)
+	}
+	for _, ds := range daemonSets.Items {
+		workloads[ds.Name] = addonWorkload{"DaemonSet", ds.Name, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled, ds.Spec.Selector}
+	}
+
+	var missing []string
+	for _, entry := range splitList(s.opts.KubeSystemAddons) {
+		optional := strings.HasPrefix(entry, "?")
+		alternatives := strings.Split(strings.TrimPrefix(entry, "?"), "|")
+		var found *addonWorkload
+		for _, name := range alternatives {
+			if workload, ok := workloads[name]; ok {
+				found = &workload
+				break
+			}
+		}
+		if found == nil {
+			if !optional {
+				missing = append(missing, alternatives[0])
+			}
+			continue
+		}
+		if found.ready < found.desired {
+			out.objectf(report.Critical, report.ResourceRef{Kind: found.kind, Namespace: metav1.NamespaceSystem, Name: found.name}, "AddonNotReady", "kube-system eklentisi %s %s hazır değil (%d/%d)", found.kind, found.name, found.ready, found.desired)
+		}
+		selector, err := metav1.LabelSelectorAsSelector(found.selector)
+		if err != nil {
+			continue
+		}
+		pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods(metav1.NamespaceSystem).List, metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			out.infof("%s %s pod'larını listelerken hata oluştu: %v", found.kind, found.name, err)
+			continue
+		}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			var crashing []string
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+					crashing = append(crashing, fmt.Sprintf("%s (%d yeniden başlatma)", status.Name, status.RestartCount))
+				}
+			}
+			if len(crashing) > 0 {
+				out.objectf(report.Critical, podRef(pod), "AddonCrashLoopBackOff", "kube-system eklentisi %s pod'u %s şu container'larda CrashLoopBackOff durumunda: %s", found.name, pod.Name, strings.Join(crashing, ", "))
+			}
+		}
+	}
+	if len(missing) > 0 {
+		out.objectf(report.Critical, namespaceRef(metav1.NamespaceSystem), "AddonMissing", "kube-system eklentileri bulunamadı: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
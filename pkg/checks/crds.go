@@ -0,0 +1,58 @@
+package checks
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// servedResource, verilen grubun sürümlerinden API server'da sunulan ilkinde
+// resource mevcutsa onun GroupVersionResource değerini döndürür. CRD'si
+// kurulmamış eklentilere ait kontrollerin atlanması için kullanılır.
+func servedResource(ctx context.Context, clientset kubernetes.Interface, group, resource string, versions ...string) (schema.GroupVersionResource, bool, error) {
+	for _, version := range versions {
+		groupVersion := schema.GroupVersion{Group: group, Version: version}
+		resources, err := clientset.Discovery().ServerResourcesForGroupVersion(groupVersion.String())
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return schema.GroupVersionResource{}, false, err
+		}
+		for _, r := range resources.APIResources {
+			if r.Name == resource {
+				return groupVersion.WithResource(resource), true, nil
+			}
+		}
+	}
+	return schema.GroupVersionResource{}, false, nil
+}
+
+// condition, unstructured nesnelerin status.conditions listesindeki bir elemandır.
+type condition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// unstructuredConditions, nesnedeki verilen yoldaki koşul listesini okur.
+func unstructuredConditions(object map[string]interface{}, fields ...string) []condition {
+	items, _, _ := unstructured.NestedSlice(object, fields...)
+	conditions := make([]condition, 0, len(items))
+	for _, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		c := condition{}
+		c.Type, _, _ = unstructured.NestedString(entry, "type")
+		c.Status, _, _ = unstructured.NestedString(entry, "status")
+		c.Reason, _, _ = unstructured.NestedString(entry, "reason")
+		c.Message, _, _ = unstructured.NestedString(entry, "message")
+		conditions = append(conditions, c)
+	}
+	return conditions
+}
@@ -0,0 +1,105 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// gpuNodeLabels, node'da GPU bulunduğunu bildiren bilinen etiketlerdir.
+var gpuNodeLabels = []string{
+	"nvidia.com/gpu.present",
+	"cloud.google.com/gke-accelerator",
+	"k8s.amazonaws.com/accelerator",
+	"feature.node.kubernetes.io/pci-10de.present",
+}
+
+// checkDevicePlugins, GPU taşıması beklenen node'ların extended resource'ları
+// allocatable olarak sunduğunu, device plugin DaemonSet'lerinin sağlıklı
+// olduğunu doğrular ve sunulmayan cihazları bekleyen Pending pod'ları raporlar.
+func (s *Suite) checkDevicePlugins(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	resources := splitList(s.opts.ExtendedResources)
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	var selector labels.Selector
+	if s.opts.GPUNodeSelector != "" {
+		selector, err = labels.Parse(s.opts.GPUNodeSelector)
+		if err != nil {
+			return checkerrors.Wrap(checkerrors.CodeInvalidConfig, err, "Geçersiz --gpu-node-selector değeri %q", s.opts.GPUNodeSelector)
+		}
+	}
+	available := map[string]int64{}
+	for _, node := range nodes.Items {
+		advertised := false
+		for _, name := range resources {
+			quantity := node.Status.Allocatable[corev1.ResourceName(name)]
+			if !quantity.IsZero() {
+				advertised = true
+				available[name] += quantity.Value()
+			}
+		}
+		expected := false
+		if selector != nil {
+			expected = selector.Matches(labels.Set(node.Labels))
+		} else {
+			for _, label := range gpuNodeLabels {
+				if _, ok := node.Labels[label]; ok {
+					expected = true
+				}
+			}
+		}
+		if expected && !advertised {
+			out.objectf(report.Warning, nodeRef(node.Name), "NoExtendedResources", "Node %s GPU node'u olarak etiketli ancak hiçbir extended resource sunmuyor (%s)", node.Name, strings.Join(resources, ", "))
+		}
+	}
+	for _, name := range resources {
+		if available[name] > 0 {
+			out.infof("Cluster'da %d allocatable %s var", available[name], name)
+		}
+	}
+
+	daemonSets, err := s.listDaemonSets(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("DaemonSet'leri", err)
+	}
+	for _, ds := range daemonSets.Items {
+		if !strings.Contains(ds.Name, "device-plugin") && !podSpecHasImage(&ds.Spec.Template.Spec, "device-plugin") {
+			continue
+		}
+		if ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+			out.objectf(report.Warning, report.ResourceRef{Kind: "DaemonSet", Namespace: ds.Namespace, Name: ds.Name}, "DevicePluginNotReady", "Device plugin DaemonSet %s namespace %s içinde %d/%d pod hazır", ds.Name, ds.Namespace, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
+		}
+	}
+
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Pending,spec.nodeName="})
+	if err != nil {
+		return checkerrors.ListFailed("Pending pod'ları", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if _, ok := unschedulableSince(pod); !ok {
+			continue
+		}
+		requests := podRequests(pod)
+		var waiting []string
+		for _, name := range resources {
+			quantity, ok := requests[corev1.ResourceName(name)]
+			if ok && !quantity.IsZero() {
+				waiting = append(waiting, fmt.Sprintf("%s %s (cluster'da allocatable: %d)", quantity.String(), name, available[name]))
+			}
+		}
+		if len(waiting) > 0 {
+			out.objectf(report.Info, podRef(pod), "WaitingForDevice", "Pod %s namespace %s şu cihazları bekliyor: %s", pod.Name, pod.Namespace, strings.Join(waiting, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package checks
+
+import (
+	"fmt"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+)
+
+// APIGroupRequirer, yalnızca belirli API gruplarının sunulduğu cluster'larda
+// anlamlı olan kontrollerin uyguladığı arayüzdür.
+type APIGroupRequirer interface {
+	RequiredAPIGroups() []string
+}
+
+// apiGatedCheck, kontrolü çalıştırılması için gereken API gruplarıyla birlikte sunar.
+type apiGatedCheck struct {
+	Check
+	groups []string
+}
+
+func (c apiGatedCheck) RequiredAPIGroups() []string {
+	return c.groups
+}
+
+// requireAPIGroups, check'in yalnızca groups API server'da sunulduğunda
+// çalıştırılması gerektiğini belirtir.
+func requireAPIGroups(check Check, groups ...string) Check {
+	return apiGatedCheck{Check: check, groups: groups}
+}
+
+// requiredAPIGroups, check'in (sarılmışsa iç kontrolün) gerektirdiği API
+// gruplarını döndürür.
+func requiredAPIGroups(check Check) []string {
+	if requirer, ok := check.(APIGroupRequirer); ok {
+		return requirer.RequiredAPIGroups()
+	}
+	return nil
+}
+
+// FilterByDiscovery, API server'ın sunduğu grupları discovery ile tek
+// istekte sorgular ve gerektirdiği API grupları sunulmayan kontrolleri
+// ayıklar. Böylece metrics.k8s.io, snapshot.storage.k8s.io, Gateway API ya
+// da CRD tabanlı eklentiler kurulu olmayan cluster'larda bu kontroller her
+// döngüde hata yazmak yerine atlanır; grup sonradan kurulursa bir sonraki
+// döngüde kendiliğinden çalışmaya başlar. Discovery başarısız olursa tüm
+// kontroller hatayla birlikte döner.
+func FilterByDiscovery(clientset kubernetes.Interface, checks []Check) (available []Check, skipped []string, err error) {
+	groups, err := clientset.Discovery().ServerGroups()
+	if err != nil {
+		return checks, nil, checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "API grupları alınırken hata oluştu, tüm kontroller çalıştırılacak")
+	}
+	served := map[string]bool{}
+	for _, group := range groups.Groups {
+		served[group.Name] = true
+	}
+	for _, check := range checks {
+		missing := ""
+		for _, group := range requiredAPIGroups(check) {
+			if !served[group] {
+				missing = group
+				break
+			}
+		}
+		if missing != "" {
+			skipped = append(skipped, fmt.Sprintf("%s (%s)", check.Name(), missing))
+			continue
+		}
+		available = append(available, check)
+	}
+	return available, skipped, nil
+}
@@ -0,0 +1,260 @@
+package checks
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// bufferedEventReasons, Warning olmayan ama kontrollerin ihtiyaç duyduğu
+// event nedenleridir; bunlar Warning event'lerinden ayrı, reason ile
+// süzülmüş birer watch ile izlenir.
+var bufferedEventReasons = []string{"NotTriggerScaleUp", "SpotInterruption", "SpotInterrupted", "RebalanceRecommendation", "ScheduledEvent", "PreemptScheduled", "Preempted"}
+
+// StartEventWatch, her döngüde cluster genelindeki tüm Event'leri listelemek
+// yerine type=Warning ve bufferedEventReasons nedenleriyle süzülmüş
+// watch'lar başlatır. Gelen event'ler managedFields ve annotation'ları
+// atılarak en fazla --event-buffer-size boyutunda bir halka tampona yazılır;
+// tampon dolduğunda en eski event'ler düşer. Watch'lar ctx iptal edilene
+// kadar çalışır ve ilk listeleri timeout içinde tamamlanmazsa kontroller
+// List kullanmaya devam eder.
+func (s *Suite) StartEventWatch(ctx context.Context, clientset kubernetes.Interface, timeout time.Duration) error {
+	selectors := []fields.Selector{fields.OneTermEqualSelector("type", corev1.EventTypeWarning)}
+	for _, reason := range bufferedEventReasons {
+		selectors = append(selectors, fields.AndSelectors(
+			fields.OneTermEqualSelector("reason", reason),
+			fields.OneTermNotEqualSelector("type", corev1.EventTypeWarning),
+		))
+	}
+
+	var rings []*eventRing
+	var stops []context.CancelFunc
+	for _, selector := range selectors {
+		ring := newEventRing(s.opts.EventBufferSize)
+		rings = append(rings, ring)
+		watchCtx, stop := context.WithCancel(ctx)
+		stops = append(stops, stop)
+		listWatch := cache.NewListWatchFromClient(clientset.CoreV1().RESTClient(), "events", metav1.NamespaceAll, selector)
+		reflector := cache.NewReflector(listWatch, &corev1.Event{}, ring, 0)
+		reflector.WatchListPageSize = s.opts.PageSize
+		go reflector.Run(watchCtx.Done())
+	}
+
+	syncCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, ring := range rings {
+		if !cache.WaitForCacheSync(syncCtx.Done(), ring.hasSynced) {
+			for _, stop := range stops {
+				stop()
+			}
+			return checkerrors.Wrap(checkerrors.CodeTimeout, syncCtx.Err(), "Event watch'ları %s içinde senkronize olmadı, Event'ler için List kullanılacak", timeout)
+		}
+	}
+	s.eventRings = rings
+	return nil
+}
+
+// listEvents, reasons nedenlerinden birine sahip cluster genelindeki
+// event'leri döndürür; reasons boşsa tüm Warning ve bufferedEventReasons
+// event'leri döner. Event watch'ları çalışıyorsa event'ler halka tampondan
+// okunup fieldSelector ile süzülür, aksi halde fieldSelector ile API'den
+// listelenir.
+func (s *Suite) listEvents(ctx context.Context, clientset kubernetes.Interface, fieldSelector string, reasons ...string) ([]corev1.Event, error) {
+	selector, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	if s.eventRings == nil {
+		events, err := listAll(ctx, s.opts, clientset.CoreV1().Events("").List, metav1.ListOptions{FieldSelector: fieldSelector})
+		if err != nil {
+			return nil, err
+		}
+		return events.Items, nil
+	}
+	wanted := map[string]bool{}
+	for _, reason := range reasons {
+		wanted[reason] = true
+	}
+	var events []corev1.Event
+	for _, ring := range s.eventRings {
+		for _, event := range ring.snapshot() {
+			if (len(wanted) == 0 || wanted[event.Reason]) && selector.Matches(eventFields(&event)) && (!isShardedContext(ctx) || ownsNamespace(s.opts, event.Namespace)) {
+				events = append(events, event)
+			}
+		}
+	}
+	return events, nil
+}
+
+// eventFields, API server'ın Event'ler için desteklediği field selector
+// alanlarını döndürür; halka tampondaki event'ler bunlarla süzülür.
+func eventFields(event *corev1.Event) fields.Set {
+	return fields.Set{
+		"metadata.name":                  event.Name,
+		"metadata.namespace":             event.Namespace,
+		"involvedObject.kind":            event.InvolvedObject.Kind,
+		"involvedObject.namespace":       event.InvolvedObject.Namespace,
+		"involvedObject.name":            event.InvolvedObject.Name,
+		"involvedObject.uid":             string(event.InvolvedObject.UID),
+		"involvedObject.apiVersion":      event.InvolvedObject.APIVersion,
+		"involvedObject.resourceVersion": event.InvolvedObject.ResourceVersion,
+		"involvedObject.fieldPath":       event.InvolvedObject.FieldPath,
+		"reason":                         event.Reason,
+		"reportingComponent":             event.ReportingController,
+		"source":                         event.Source.Component,
+		"type":                           event.Type,
+	}
+}
+
+// eventRing, tek bir event watch'unun son event'lerini sabit boyutlu bir
+// halka tamponda tutar. Reflector'ın Store'u olarak kullanılır; aynı event
+// güncellendiğinde (ör. Count arttığında) tampondaki yeri değişmez.
+type eventRing struct {
+	mu     sync.Mutex
+	items  []*corev1.Event
+	next   int
+	index  map[types.UID]int
+	synced bool
+}
+
+func newEventRing(size int) *eventRing {
+	return &eventRing{items: make([]*corev1.Event, max(size, 1)), index: map[types.UID]int{}}
+}
+
+func (r *eventRing) put(obj interface{}) {
+	event, ok := obj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	// Kontroller yalnızca event'in nedenini, kaynağını ve ilgili nesnesini
+	// okuduğundan tamponda gereksiz metadata tutulmaz.
+	trimmed := event.DeepCopy()
+	trimmed.ManagedFields = nil
+	trimmed.Annotations = nil
+	if i, ok := r.index[event.UID]; ok {
+		r.items[i] = trimmed
+		return
+	}
+	if old := r.items[r.next]; old != nil {
+		delete(r.index, old.UID)
+	}
+	r.items[r.next] = trimmed
+	r.index[event.UID] = r.next
+	r.next = (r.next + 1) % len(r.items)
+}
+
+func (r *eventRing) remove(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	event, ok := obj.(*corev1.Event)
+	if !ok {
+		return
+	}
+	if i, ok := r.index[event.UID]; ok {
+		r.items[i] = nil
+		delete(r.index, event.UID)
+	}
+}
+
+func (r *eventRing) snapshot() []corev1.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	events := make([]corev1.Event, 0, len(r.index))
+	for i := range r.items {
+		// En eskiden en yeniye doğru sıralanır.
+		if event := r.items[(r.next+i)%len(r.items)]; event != nil {
+			events = append(events, *event)
+		}
+	}
+	return events
+}
+
+func (r *eventRing) hasSynced() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.synced
+}
+
+// Aşağıdaki metotlar eventRing'in cache.Store olarak kullanılmasını sağlar.
+
+func (r *eventRing) Add(obj interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.put(obj)
+	return nil
+}
+
+func (r *eventRing) Update(obj interface{}) error {
+	return r.Add(obj)
+}
+
+func (r *eventRing) Delete(obj interface{}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.remove(obj)
+	return nil
+}
+
+func (r *eventRing) List() []interface{} {
+	var objects []interface{}
+	for _, event := range r.snapshot() {
+		event := event
+		objects = append(objects, &event)
+	}
+	return objects
+}
+
+func (r *eventRing) ListKeys() []string {
+	var keys []string
+	for _, event := range r.snapshot() {
+		keys = append(keys, event.Namespace+"/"+event.Name)
+	}
+	return keys
+}
+
+func (r *eventRing) Get(obj interface{}) (item interface{}, exists bool, err error) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return nil, false, err
+	}
+	return r.GetByKey(key)
+}
+
+func (r *eventRing) GetByKey(key string) (item interface{}, exists bool, err error) {
+	for _, event := range r.snapshot() {
+		if event.Namespace+"/"+event.Name == key {
+			return &event, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// Replace, reflector her yeniden listelediğinde tamponu listenin son
+// elemanlarıyla yeniden doldurur.
+func (r *eventRing) Replace(list []interface{}, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.items {
+		r.items[i] = nil
+	}
+	r.next = 0
+	r.index = map[types.UID]int{}
+	for _, obj := range list {
+		r.put(obj)
+	}
+	r.synced = true
+	return nil
+}
+
+func (r *eventRing) Resync() error {
+	return nil
+}
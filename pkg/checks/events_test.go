@@ -0,0 +1,111 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func testEvent(i int) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        fmt.Sprintf("event-%d", i),
+			UID:         types.UID(fmt.Sprint(i)),
+			Annotations: map[string]string{"a": "b"},
+		},
+		Reason: "BackOff",
+	}
+}
+
+func eventNames(events []corev1.Event) []string {
+	var names []string
+	for _, event := range events {
+		names = append(names, event.Name)
+	}
+	return names
+}
+
+func TestEventRingDropsOldest(t *testing.T) {
+	ring := newEventRing(3)
+	for i := 0; i < 5; i++ {
+		ring.Add(testEvent(i))
+	}
+	got := fmt.Sprint(eventNames(ring.snapshot()))
+	if want := "[event-2 event-3 event-4]"; got != want {
+		t.Errorf("tampon %s olmalı, dönen %s", want, got)
+	}
+	if _, exists, _ := ring.GetByKey("default/event-0"); exists {
+		t.Error("düşen event tamponda bulunmamalı")
+	}
+}
+
+func TestEventRingUpdateKeepsPosition(t *testing.T) {
+	ring := newEventRing(3)
+	for i := 0; i < 3; i++ {
+		ring.Add(testEvent(i))
+	}
+	updated := testEvent(0)
+	updated.Count = 7
+	ring.Update(updated)
+
+	events := ring.snapshot()
+	if got := fmt.Sprint(eventNames(events)); got != "[event-0 event-1 event-2]" {
+		t.Errorf("güncellenen event'in yeri değişmemeli, dönen %s", got)
+	}
+	if events[0].Count != 7 {
+		t.Errorf("güncellenen event'in Count'u 7 olmalı, dönen %d", events[0].Count)
+	}
+	if events[0].Annotations != nil {
+		t.Error("tampondaki event'lerin annotation'ları atılmalı")
+	}
+}
+
+func TestEventRingDeleteAndReplace(t *testing.T) {
+	ring := newEventRing(3)
+	for i := 0; i < 3; i++ {
+		ring.Add(testEvent(i))
+	}
+	ring.Delete(testEvent(1))
+	if got := fmt.Sprint(eventNames(ring.snapshot())); got != "[event-0 event-2]" {
+		t.Errorf("silinen event tampondan çıkmalı, dönen %s", got)
+	}
+	if ring.hasSynced() {
+		t.Error("Replace çağrılmadan tampon senkronize sayılmamalı")
+	}
+
+	var list []interface{}
+	for i := 10; i < 15; i++ {
+		list = append(list, testEvent(i))
+	}
+	ring.Replace(list, "")
+	if got := fmt.Sprint(eventNames(ring.snapshot())); got != "[event-12 event-13 event-14]" {
+		t.Errorf("Replace listenin son elemanlarını tutmalı, dönen %s", got)
+	}
+	if !ring.hasSynced() {
+		t.Error("Replace sonrası tampon senkronize olmalı")
+	}
+}
+
+func TestListEventsFiltersRingsBySelector(t *testing.T) {
+	ring := newEventRing(4)
+	for i := 0; i < 4; i++ {
+		event := testEvent(i)
+		if i%2 == 0 {
+			event.Reason = "FailedCreate"
+		}
+		ring.Add(event)
+	}
+	s := &Suite{eventRings: []*eventRing{ring}}
+	events, err := s.listEvents(context.Background(), nil, "reason=FailedCreate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(eventNames(events)); got != "[event-0 event-2]" {
+		t.Errorf("yalnızca seçiciye uyan event'ler dönmeli, dönen %s", got)
+	}
+}
@@ -0,0 +1,70 @@
+package checks
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// kubeletSummary, kubelet'in /stats/summary cevabının bu araçta kullanılan kısmıdır.
+type kubeletSummary struct {
+	Node kubeletNodeStats  `json:"node"`
+	Pods []kubeletPodStats `json:"pods"`
+}
+
+type kubeletNodeStats struct {
+	NodeName string            `json:"nodeName"`
+	Fs       *kubeletFsStats   `json:"fs,omitempty"`
+	Runtime  *kubeletRuntimeFs `json:"runtime,omitempty"`
+}
+
+type kubeletRuntimeFs struct {
+	ImageFs *kubeletFsStats `json:"imageFs,omitempty"`
+}
+
+type kubeletPodStats struct {
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"podRef"`
+	EphemeralStorage *kubeletFsStats      `json:"ephemeral-storage,omitempty"`
+	Volumes          []kubeletVolumeStats `json:"volume,omitempty"`
+}
+
+type kubeletVolumeStats struct {
+	kubeletFsStats
+	Name   string `json:"name"`
+	PVCRef *struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"pvcRef,omitempty"`
+	VolumeHealthStats *struct {
+		Abnormal bool `json:"abnormal"`
+	} `json:"volumeHealthStats,omitempty"`
+}
+
+type kubeletFsStats struct {
+	AvailableBytes *uint64 `json:"availableBytes,omitempty"`
+	CapacityBytes  *uint64 `json:"capacityBytes,omitempty"`
+	UsedBytes      *uint64 `json:"usedBytes,omitempty"`
+	InodesFree     *uint64 `json:"inodesFree,omitempty"`
+	Inodes         *uint64 `json:"inodes,omitempty"`
+	InodesUsed     *uint64 `json:"inodesUsed,omitempty"`
+}
+
+// getKubeletSummary, API server'ın node proxy'si üzerinden verilen node'un
+// kubelet özet istatistiklerini alır.
+func getKubeletSummary(ctx context.Context, clientset kubernetes.Interface, nodeName string) (*kubeletSummary, error) {
+	data, err := clientset.CoreV1().RESTClient().Get().
+		Resource("nodes").Name(nodeName).SubResource("proxy").Suffix("stats/summary").
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	summary := &kubeletSummary{}
+	if err := json.Unmarshal(data, summary); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
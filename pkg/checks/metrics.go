@@ -0,0 +1,131 @@
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// resourceMetricsList, metrics.k8s.io NodeMetricsList ve PodMetricsList
+// cevaplarının bu araçta kullanılan kısmıdır.
+type resourceMetricsList struct {
+	Items []struct {
+		Metadata struct {
+			Name      string `json:"name"`
+			Namespace string `json:"namespace"`
+		} `json:"metadata"`
+		Usage      corev1.ResourceList `json:"usage"`
+		Containers []struct {
+			Name  string              `json:"name"`
+			Usage corev1.ResourceList `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// checkMetricsServer, metrics.k8s.io API'sinin sunulduğunu doğrular ve
+// node'ların gerçek CPU/bellek kullanımını allocatable değerleriyle
+// karşılaştırır. Pod'lar için container kullanımı istek ve limitlerle
+// kıyaslanır; CPU limitine yaklaşan (throttle edilmesi muhtemel) ve bellek
+// limitine yaklaşan (OOMKill riski taşıyan) container'lar raporlanır.
+func (s *Suite) checkMetricsServer(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	_, served, err := servedResource(ctx, clientset, "metrics.k8s.io", "nodes", "v1beta1")
+	if err != nil {
+		out.criticalf("metrics.k8s.io API'sine erişilemiyor: %v", err)
+		return nil
+	}
+	if !served {
+		out.warningf("metrics.k8s.io API'si sunulmuyor; metrics-server kurulu değil")
+		return nil
+	}
+
+	nodeMetrics := &resourceMetricsList{}
+	if err := getResourceMetrics(ctx, clientset, "nodes", nodeMetrics); err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Node metriklerini alırken hata oluştu")
+	}
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	allocatable := map[string]corev1.ResourceList{}
+	for _, node := range nodes.Items {
+		allocatable[node.Name] = node.Status.Allocatable
+	}
+	for _, item := range nodeMetrics.Items {
+		cpu, memory := item.Usage[corev1.ResourceCPU], item.Usage[corev1.ResourceMemory]
+		out.infof("Node %s kullanımı: CPU %s (%%%.0f), bellek %s (%%%.0f)", item.Metadata.Name,
+			cpu.String(), quantityPercent(cpu, allocatable[item.Metadata.Name][corev1.ResourceCPU]),
+			memory.String(), quantityPercent(memory, allocatable[item.Metadata.Name][corev1.ResourceMemory]))
+	}
+
+	podMetrics := &resourceMetricsList{}
+	if err := getResourceMetrics(ctx, clientset, "pods", podMetrics); err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Pod metriklerini alırken hata oluştu")
+	}
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return checkerrors.ListFailed("Pod'ları", err)
+	}
+	containers := map[string]corev1.ResourceRequirements{}
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			containers[pod.Namespace+"/"+pod.Name+"/"+container.Name] = container.Resources
+		}
+	}
+	for _, item := range podMetrics.Items {
+		var highMemory []string
+		for _, container := range item.Containers {
+			resources, ok := containers[item.Metadata.Namespace+"/"+item.Metadata.Name+"/"+container.Name]
+			if !ok {
+				continue
+			}
+			cpu, memory := container.Usage[corev1.ResourceCPU], container.Usage[corev1.ResourceMemory]
+			if limit, ok := resources.Limits[corev1.ResourceCPU]; ok {
+				if percent := quantityPercent(cpu, limit); percent >= s.opts.CPULimitThreshold {
+					out.infof("Pod %s namespace %s container %s CPU limitinin %%%.0f'ini kullanıyor; throttle ediliyor olabilir (%s / %s)", item.Metadata.Name, item.Metadata.Namespace, container.Name, percent, cpu.String(), limit.String())
+				}
+			}
+			if limit, ok := resources.Limits[corev1.ResourceMemory]; ok {
+				if percent := quantityPercent(memory, limit); percent >= s.opts.MemoryLimitThreshold {
+					highMemory = append(highMemory, fmt.Sprintf("%s %%%.0f (%s / %s)", container.Name, percent, memory.String(), limit.String()))
+				}
+			}
+			if request, ok := resources.Requests[corev1.ResourceMemory]; ok && !request.IsZero() && memory.Cmp(request) > 0 {
+				out.infof("Pod %s namespace %s container %s bellek isteğinin üzerinde çalışıyor (%s / %s)", item.Metadata.Name, item.Metadata.Namespace, container.Name, memory.String(), request.String())
+			}
+		}
+		if len(highMemory) > 0 {
+			out.objectf(report.Warning, report.ResourceRef{Kind: "Pod", Namespace: item.Metadata.Namespace, Name: item.Metadata.Name}, "MemoryLimitNearlyExhausted", "Pod %s namespace %s container'ları bellek limitine yaklaşıyor: %s", item.Metadata.Name, item.Metadata.Namespace, strings.Join(highMemory, ", "))
+		}
+	}
+	return nil
+}
+
+// getResourceMetrics, metrics.k8s.io/v1beta1 altındaki verilen resource'un
+// tüm cluster'daki metriklerini alır. İstemci protobuf tercih ettiğinden
+// metrics-server'ın protobuf yanıt vermemesi için JSON açıkça istenir.
+func getResourceMetrics(ctx context.Context, clientset kubernetes.Interface, resource string, into *resourceMetricsList) error {
+	data, err := clientset.CoreV1().RESTClient().Get().AbsPath("/apis/metrics.k8s.io/v1beta1", resource).
+		SetHeader("Accept", runtime.ContentTypeJSON).
+		DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, into)
+}
+
+// quantityPercent, used miktarının total içindeki yüzdesini döndürür.
+func quantityPercent(used, total resource.Quantity) float64 {
+	if total.IsZero() {
+		return 0
+	}
+	return float64(used.MilliValue()) / float64(total.MilliValue()) * 100
+}
@@ -0,0 +1,858 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// checkServices, selector'ı olduğu halde EndpointSlice'larında hiç hazır adres
+// bulunmayan Service'leri raporlar. Bu durumun en yaygın nedeni selector'daki
+// bir yazım hatasıdır. ExternalName ve selector'sız Service'ler endpoint'lerini
+// kendileri yönetmediği için kontrol dışı bırakılır.
+func (s *Suite) checkServices(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	services, err := s.listServices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Service'leri", err)
+	}
+	readyEndpoints, err := s.readyEndpointCounts(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("EndpointSlice'ları", err)
+	}
+	out.infof("Cluster'da %d service var", len(services.Items))
+
+	for _, service := range services.Items {
+		if service.Spec.Type == corev1.ServiceTypeExternalName || len(service.Spec.Selector) == 0 {
+			continue
+		}
+		if readyEndpoints[service.Namespace+"/"+service.Name] == 0 {
+			out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "NoReadyEndpoints", "Service %s namespace %s içinde hiç hazır endpoint'e sahip değil (selector: %v)", service.Name, service.Namespace, service.Spec.Selector)
+		}
+	}
+	return nil
+}
+
+// readyEndpointCounts, namespace/service anahtarı ile her Service'in
+// EndpointSlice'larındaki hazır adres sayısını döndürür.
+func (s *Suite) readyEndpointCounts(ctx context.Context, clientset kubernetes.Interface) (map[string]int, error) {
+	slices, err := s.listEndpointSlices(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	counts := map[string]int{}
+	for _, slice := range slices.Items {
+		service, ok := slice.Labels[discoveryv1.LabelServiceName]
+		if !ok {
+			continue
+		}
+		key := slice.Namespace + "/" + service
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				counts[key] += len(endpoint.Addresses)
+			}
+		}
+	}
+	return counts, nil
+}
+
+// checkEndpointSlices, uzun süredir hazır olmayan endpoint'leri, sonlanmakta
+// olup EndpointSlice'tan çıkmayan endpoint'leri ve artık var olmayan pod'lara
+// işaret eden adresleri raporlar.
+func (s *Suite) checkEndpointSlices(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	slices, err := s.listEndpointSlices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("EndpointSlice'ları", err)
+	}
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("EndpointSlice kontrolü için pod'ları", err)
+	}
+	existingPods := map[string]*corev1.Pod{}
+	for i := range pods.Items {
+		existingPods[pods.Items[i].Namespace+"/"+pods.Items[i].Name] = &pods.Items[i]
+	}
+
+	for _, slice := range slices.Items {
+		for _, endpoint := range slice.Endpoints {
+			if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" {
+				continue
+			}
+			podName := endpoint.TargetRef.Name
+			pod, ok := existingPods[slice.Namespace+"/"+podName]
+			if !ok {
+				out.objectf(report.Info, report.ResourceRef{Kind: "Pod", Namespace: slice.Namespace, Name: podName}, "StaleEndpoint", "EndpointSlice %s namespace %s içinde silinmiş pod %s adresine işaret ediyor: %v", slice.Name, slice.Namespace, podName, endpoint.Addresses)
+				continue
+			}
+			if endpoint.Conditions.Terminating != nil && *endpoint.Conditions.Terminating {
+				if pod.DeletionTimestamp != nil && time.Since(pod.DeletionTimestamp.Time) > s.opts.EndpointStaleThreshold {
+					out.objectf(report.Info, podRef(pod), "TerminatingEndpoint", "EndpointSlice %s namespace %s içinde terminating endpoint %s %s süredir kaldırılmadı", slice.Name, slice.Namespace, podName, time.Since(pod.DeletionTimestamp.Time).Round(time.Second))
+				}
+				continue
+			}
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				since := podReadyTransition(pod)
+				if time.Since(since) > s.opts.EndpointStaleThreshold {
+					out.objectf(report.Info, podRef(pod), "EndpointNotReady", "EndpointSlice %s namespace %s içinde endpoint %s %s süredir hazır değil", slice.Name, slice.Namespace, podName, time.Since(since).Round(time.Second))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// podReadyTransition, pod'un Ready koşulunun en son değiştiği zamanı döndürür.
+func podReadyTransition(pod *corev1.Pod) time.Time {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return pod.CreationTimestamp.Time
+}
+
+// checkIngresses, Ingress'lerin referans verdiği Service ve port'ların var
+// olduğunu, bu Service'lerin hazır endpoint'leri bulunduğunu ve kullanılan
+// IngressClass'ın tanımlı olduğunu doğrular. Bozuk yönlendirmeler host/path
+// bazında raporlanır.
+func (s *Suite) checkIngresses(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	ingresses, err := s.listIngresses(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Ingress'leri", err)
+	}
+	if len(ingresses.Items) == 0 {
+		return nil
+	}
+	classes, err := listAll(ctx, s.opts, clientset.NetworkingV1().IngressClasses().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("IngressClass'ları", err)
+	}
+	services, err := s.listServices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Ingress kontrolü için Service'leri", err)
+	}
+	readyEndpoints, err := s.readyEndpointCounts(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("EndpointSlice'ları", err)
+	}
+
+	classNames := map[string]bool{}
+	hasDefaultClass := false
+	for _, class := range classes.Items {
+		classNames[class.Name] = true
+		if class.Annotations[networkingv1.AnnotationIsDefaultIngressClass] == "true" {
+			hasDefaultClass = true
+		}
+	}
+	serviceByKey := map[string]*corev1.Service{}
+	for i := range services.Items {
+		serviceByKey[services.Items[i].Namespace+"/"+services.Items[i].Name] = &services.Items[i]
+	}
+
+	for _, ingress := range ingresses.Items {
+		className := ""
+		if ingress.Spec.IngressClassName != nil {
+			className = *ingress.Spec.IngressClassName
+		} else if annotation, ok := ingress.Annotations["kubernetes.io/ingress.class"]; ok {
+			// Eski annotation'ı kullanan controller'lar IngressClass nesnesi gerektirmeyebilir.
+			className = annotation
+			classNames[annotation] = true
+		}
+		if className == "" && !hasDefaultClass {
+			out.objectf(report.Info, report.ResourceRef{Kind: "Ingress", Namespace: ingress.Namespace, Name: ingress.Name}, "NoIngressClass", "Ingress %s namespace %s içinde IngressClass belirtmiyor ve varsayılan IngressClass yok", ingress.Name, ingress.Namespace)
+		} else if className != "" && !classNames[className] {
+			out.objectf(report.Info, report.ResourceRef{Kind: "Ingress", Namespace: ingress.Namespace, Name: ingress.Name}, "MissingIngressClass", "Ingress %s namespace %s içinde var olmayan IngressClass %s kullanıyor", ingress.Name, ingress.Namespace, className)
+		}
+
+		// Bozuk yönlendirmeler Ingress başına tek bir bulguda toplanır.
+		var broken []string
+		check := func(route string, backend *networkingv1.IngressBackend) {
+			if backend == nil || backend.Service == nil {
+				return
+			}
+			if problem := ingressBackendProblem(ingress.Namespace, backend.Service, serviceByKey, readyEndpoints); problem != "" {
+				broken = append(broken, route+": "+problem)
+			}
+		}
+		check("varsayılan backend", ingress.Spec.DefaultBackend)
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			host := rule.Host
+			if host == "" {
+				host = "*"
+			}
+			for _, path := range rule.HTTP.Paths {
+				check(host+path.Path, &path.Backend)
+			}
+		}
+		if len(broken) > 0 {
+			out.objectf(report.Info, report.ResourceRef{Kind: "Ingress", Namespace: ingress.Namespace, Name: ingress.Name}, "BrokenBackend", "Ingress %s namespace %s içinde bozuk yönlendirmeler: %s", ingress.Name, ingress.Namespace, strings.Join(broken, "; "))
+		}
+	}
+	return nil
+}
+
+// ingressBackendProblem, bir Ingress backend'i ile ilgili sorunu açıklayan bir
+// metin döndürür; backend sağlıklıysa boş döner.
+func ingressBackendProblem(namespace string, backend *networkingv1.IngressServiceBackend, services map[string]*corev1.Service, readyEndpoints map[string]int) string {
+	key := namespace + "/" + backend.Name
+	service, ok := services[key]
+	if !ok {
+		return fmt.Sprintf("Service %s bulunamadı", backend.Name)
+	}
+	portFound := false
+	for _, port := range service.Spec.Ports {
+		if (backend.Port.Name != "" && port.Name == backend.Port.Name) || (backend.Port.Number != 0 && port.Port == backend.Port.Number) {
+			portFound = true
+			break
+		}
+	}
+	if !portFound {
+		return fmt.Sprintf("Service %s üzerinde port %s bulunamadı", backend.Name, ingressBackendPort(backend.Port))
+	}
+	if service.Spec.Type != corev1.ServiceTypeExternalName && readyEndpoints[key] == 0 {
+		return fmt.Sprintf("Service %s hiç hazır endpoint'e sahip değil", backend.Name)
+	}
+	return ""
+}
+
+func ingressBackendPort(port networkingv1.ServiceBackendPort) string {
+	if port.Name != "" {
+		return port.Name
+	}
+	return fmt.Sprint(port.Number)
+}
+
+// checkCoreDNS, kube-system'deki CoreDNS/kube-dns Deployment'ının sağlığını
+// kontrol eder. --dns-probe verildiğinde kube-dns Service'i üzerinden cluster
+// içi ve dış adları çözerek gecikme ve hataları raporlar.
+func (s *Suite) checkCoreDNS(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	deployments, err := listAll(ctx, s.opts, clientset.AppsV1().Deployments(metav1.NamespaceSystem).List, metav1.ListOptions{LabelSelector: "k8s-app=kube-dns"})
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "CoreDNS Deployment'ını alırken hata oluştu")
+	}
+	if len(deployments.Items) == 0 {
+		out.objectf(report.Critical, namespaceRef(metav1.NamespaceSystem), "DNSDeploymentMissing", "kube-system içinde CoreDNS/kube-dns Deployment'ı bulunamadı")
+	}
+	for _, deployment := range deployments.Items {
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		if deployment.Status.ReadyReplicas == 0 {
+			out.objectf(report.Critical, report.ResourceRef{Kind: "Deployment", Namespace: deployment.Namespace, Name: deployment.Name}, "NoReadyReplicas", "DNS Deployment'ı %s hiç hazır replica'ya sahip değil", deployment.Name)
+		} else if deployment.Status.ReadyReplicas < desired {
+			out.objectf(report.Warning, report.ResourceRef{Kind: "Deployment", Namespace: deployment.Namespace, Name: deployment.Name}, "ReplicasNotReady", "DNS Deployment'ı %s %d/%d replica hazır", deployment.Name, deployment.Status.ReadyReplicas, desired)
+		}
+	}
+
+	if !s.opts.DNSProbe {
+		return nil
+	}
+	resolver, server, err := clusterDNSResolver(ctx, clientset)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "kube-dns Service'ini alırken hata oluştu")
+	}
+	for _, name := range strings.Split(s.opts.DNSProbeNames, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(ctx, s.opts.DNSProbeTimeout)
+		start := time.Now()
+		addresses, err := resolver.LookupHost(ctx, name)
+		elapsed := time.Since(start)
+		cancel()
+		if err != nil {
+			out.infof("DNS sorgusu %s başarısız oldu (%s üzerinden, %s): %v", name, server, elapsed.Round(time.Millisecond), err)
+			continue
+		}
+		out.infof("DNS sorgusu %s %s içinde çözüldü: %v", name, elapsed.Round(time.Millisecond), addresses)
+	}
+	return nil
+}
+
+// clusterDNSResolver, sorguları doğrudan kube-dns Service'inin ClusterIP
+// adresine gönderen bir resolver ve kullanılan sunucu adresini döndürür.
+func clusterDNSResolver(ctx context.Context, clientset kubernetes.Interface) (*net.Resolver, string, error) {
+	service, err := clientset.CoreV1().Services(metav1.NamespaceSystem).Get(ctx, "kube-dns", metav1.GetOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	server := net.JoinHostPort(service.Spec.ClusterIP, "53")
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, server)
+		},
+	}
+	return resolver, server, nil
+}
+
+// checkKubeProxy, kube-proxy'nin (ya da onun yerini alan CNI ajanının) her
+// node'da çalışıp hazır olduğunu doğrular. Bozuk bir kube-proxy Service'leri
+// sessizce bozduğu için eksik node'lar tek tek raporlanır.
+func (s *Suite) checkKubeProxy(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	var daemonSet *appsv1.DaemonSet
+	for _, name := range strings.Split(s.opts.ServiceProxyDaemonSets, ",") {
+		ds, err := clientset.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(ctx, strings.TrimSpace(name), metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "DaemonSet %s alınırken hata oluştu", name)
+		}
+		daemonSet = ds
+		break
+	}
+	if daemonSet == nil {
+		out.objectf(report.Critical, namespaceRef(metav1.NamespaceSystem), "ServiceProxyMissing", "kube-system içinde kube-proxy ya da yerini alan bir DaemonSet bulunamadı (%s)", s.opts.ServiceProxyDaemonSets)
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(daemonSet.Spec.Selector)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "DaemonSet %s selector'ı çözülemedi", daemonSet.Name)
+	}
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods(metav1.NamespaceSystem).List, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeListFailed, err, "DaemonSet %s pod'larını listelerken hata oluştu", daemonSet.Name)
+	}
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+
+	readyOnNode := map[string]bool{}
+	for i := range pods.Items {
+		if isPodReady(&pods.Items[i]) {
+			readyOnNode[pods.Items[i].Spec.NodeName] = true
+		}
+	}
+	nodeSelector := labels.SelectorFromSet(daemonSet.Spec.Template.Spec.NodeSelector)
+	for _, node := range nodes.Items {
+		if !nodeSelector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		if !readyOnNode[node.Name] {
+			out.objectf(report.Critical, nodeRef(node.Name), "ServiceProxyNotReady", "Node %s üzerinde hazır bir %s pod'u yok", node.Name, daemonSet.Name)
+		}
+	}
+	return nil
+}
+
+// checkLoadBalancers, status.loadBalancer.ingress alanı
+// --loadbalancer-pending-threshold süresinden uzun süredir boş olan
+// LoadBalancer Service'lerini, bu durumu açıklayan son cloud-provider
+// event'leriyle birlikte raporlar.
+func (s *Suite) checkLoadBalancers(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	services, err := s.listServices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("LoadBalancer Service'lerini", err)
+	}
+	for _, service := range services.Items {
+		if service.Spec.Type != corev1.ServiceTypeLoadBalancer || len(service.Status.LoadBalancer.Ingress) > 0 {
+			continue
+		}
+		age := time.Since(service.CreationTimestamp.Time)
+		if age < s.opts.LoadBalancerPendingThreshold {
+			continue
+		}
+		out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "LoadBalancerPending", "LoadBalancer Service %s namespace %s içinde %s süredir adres almadı", service.Name, service.Namespace, age.Round(time.Second))
+
+		events, err := listAll(ctx, s.opts, clientset.CoreV1().Events(service.Namespace).List, metav1.ListOptions{
+			FieldSelector: fields.Set{"involvedObject.kind": "Service", "involvedObject.name": service.Name}.String(),
+		})
+		if err != nil {
+			out.infof("Service %s event'lerini listelerken hata oluştu: %v", service.Name, err)
+			continue
+		}
+		for _, event := range events.Items {
+			if event.Type == corev1.EventTypeWarning {
+				out.infof("  %s: %s", event.Reason, event.Message)
+			}
+		}
+	}
+	return nil
+}
+
+// checkNodePorts, kullanılan NodePort'ları yapılandırılmış aralıkla
+// karşılaştırır, aralığın dolmak üzere olduğu durumları ve aynı port'u
+// kullanan ya da aralık dışında kalan Service'leri raporlar.
+func (s *Suite) checkNodePorts(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	var low, high int32
+	if _, err := fmt.Sscanf(s.opts.NodePortRange, "%d-%d", &low, &high); err != nil || low > high {
+		return checkerrors.Wrap(checkerrors.CodeInvalidConfig, err, "Geçersiz NodePort aralığı %q", s.opts.NodePortRange)
+	}
+	services, err := s.listServices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("NodePort kontrolü için Service'leri", err)
+	}
+
+	owners := map[int32][]string{}
+	for _, service := range services.Items {
+		key := service.Namespace + "/" + service.Name
+		ports := map[int32]bool{}
+		for _, port := range service.Spec.Ports {
+			if port.NodePort != 0 {
+				ports[port.NodePort] = true
+			}
+		}
+		if service.Spec.HealthCheckNodePort != 0 {
+			ports[service.Spec.HealthCheckNodePort] = true
+		}
+		var outOfRange []int32
+		for port := range ports {
+			owners[port] = append(owners[port], key)
+			if port < low || port > high {
+				outOfRange = append(outOfRange, port)
+			}
+		}
+		if len(outOfRange) > 0 {
+			sort.Slice(outOfRange, func(i, j int) bool { return outOfRange[i] < outOfRange[j] })
+			out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "NodePortOutOfRange", "Service %s NodePort %v kullanıyor, bu port(lar) %s aralığının dışında", key, outOfRange, s.opts.NodePortRange)
+		}
+	}
+
+	for port, services := range owners {
+		if len(services) > 1 {
+			out.infof("NodePort %d birden fazla Service tarafından kullanılıyor: %s", port, strings.Join(services, ", "))
+		}
+	}
+	size := int(high-low) + 1
+	usage := float64(len(owners)) / float64(size) * 100
+	out.infof("Cluster'da %d/%d NodePort kullanılıyor", len(owners), size)
+	if usage >= s.opts.NodePortUsageThreshold {
+		out.warningf("NodePort aralığının %%%.0f kadarı dolu", usage)
+	}
+	return nil
+}
+
+// checkStatefulSetServices, her StatefulSet'in yönetici headless Service'inin
+// var olduğunu ve gerçekten headless olduğunu doğrular. --dns-probe verildiğinde
+// pod başına DNS kayıtlarının çözüldüğü de kontrol edilir; bu kayıtlar
+// kümelenen uygulamaların birbirini bulması için gereklidir.
+func (s *Suite) checkStatefulSetServices(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	statefulSets, err := s.listStatefulSets(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("StatefulSet'leri", err)
+	}
+	var resolver *net.Resolver
+	if s.opts.DNSProbe && len(statefulSets.Items) > 0 {
+		resolver, _, err = clusterDNSResolver(ctx, clientset)
+		if err != nil {
+			out.infof("kube-dns Service'ini alırken hata oluştu: %v", err)
+		}
+	}
+
+	for _, statefulSet := range statefulSets.Items {
+		if statefulSet.Spec.ServiceName == "" {
+			out.objectf(report.Info, report.ResourceRef{Kind: "StatefulSet", Namespace: statefulSet.Namespace, Name: statefulSet.Name}, "NoGoverningService", "StatefulSet %s namespace %s içinde yönetici Service tanımlamıyor", statefulSet.Name, statefulSet.Namespace)
+			continue
+		}
+		service, err := clientset.CoreV1().Services(statefulSet.Namespace).Get(ctx, statefulSet.Spec.ServiceName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			out.objectf(report.Info, report.ResourceRef{Kind: "StatefulSet", Namespace: statefulSet.Namespace, Name: statefulSet.Name}, "MissingGoverningService", "StatefulSet %s namespace %s içinde yönetici Service %s bulunamadı", statefulSet.Name, statefulSet.Namespace, statefulSet.Spec.ServiceName)
+			continue
+		} else if err != nil {
+			out.infof("Service %s alınırken hata oluştu: %v", statefulSet.Spec.ServiceName, err)
+			continue
+		}
+		if service.Spec.ClusterIP != corev1.ClusterIPNone {
+			out.objectf(report.Info, report.ResourceRef{Kind: "StatefulSet", Namespace: statefulSet.Namespace, Name: statefulSet.Name}, "GoverningServiceNotHeadless", "StatefulSet %s namespace %s içinde yönetici Service %s headless değil, pod DNS kayıtları oluşmaz", statefulSet.Name, statefulSet.Namespace, service.Name)
+			continue
+		}
+		if resolver == nil {
+			continue
+		}
+
+		replicas := int32(1)
+		if statefulSet.Spec.Replicas != nil {
+			replicas = *statefulSet.Spec.Replicas
+		}
+		for ordinal := int32(0); ordinal < replicas; ordinal++ {
+			pod := fmt.Sprintf("%s-%d", statefulSet.Name, ordinal)
+			name := fmt.Sprintf("%s.%s.%s.svc.%s", pod, service.Name, statefulSet.Namespace, s.opts.ClusterDomain)
+			ctx, cancel := context.WithTimeout(ctx, s.opts.DNSProbeTimeout)
+			_, err := resolver.LookupHost(ctx, name)
+			cancel()
+			if err != nil {
+				out.objectf(report.Info, report.ResourceRef{Kind: "Pod", Namespace: statefulSet.Namespace, Name: pod}, "PodDNSLookupFailed", "StatefulSet %s namespace %s içinde pod DNS kaydı %s çözülemedi: %v", statefulSet.Name, statefulSet.Namespace, name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// checkExternalNameServices, ExternalName Service'lerinin CNAME hedeflerini
+// çözer; çözülemeyen ya da cluster içi bir adla çakışan hedefleri raporlar.
+func (s *Suite) checkExternalNameServices(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	services, err := s.listServices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("ExternalName Service'lerini", err)
+	}
+	internalSuffix := ".svc." + s.opts.ClusterDomain
+	for _, service := range services.Items {
+		if service.Spec.Type != corev1.ServiceTypeExternalName {
+			continue
+		}
+		target := strings.TrimSuffix(service.Spec.ExternalName, ".")
+		if target == "" {
+			out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "ExternalNameEmpty", "ExternalName Service %s namespace %s içinde hedef tanımlamıyor", service.Name, service.Namespace)
+			continue
+		}
+		if strings.HasSuffix(target, "."+s.opts.ClusterDomain) {
+			out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "ExternalNameInCluster", "ExternalName Service %s namespace %s içinde cluster içi bir ada işaret ediyor: %s", service.Name, service.Namespace, target)
+		}
+		if target == service.Name+"."+service.Namespace+internalSuffix {
+			out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "ExternalNameSelfReference", "ExternalName Service %s namespace %s kendisine işaret ediyor", service.Name, service.Namespace)
+			continue
+		}
+		ctx, cancel := context.WithTimeout(ctx, s.opts.DNSProbeTimeout)
+		_, err := net.DefaultResolver.LookupHost(ctx, target)
+		cancel()
+		if err != nil {
+			out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "ExternalNameLookupFailed", "ExternalName Service %s namespace %s hedefi %s çözülemedi: %v", service.Name, service.Namespace, target, err)
+		}
+	}
+	return nil
+}
+
+// checkDualStack, dual-stack cluster'larda Service'lerin ipFamilyPolicy ile
+// uyumlu olarak her iki IP ailesinden adres aldığını ve pod'ların hem IPv4
+// hem IPv6 adresine sahip olduğunu doğrular. Cluster dual-stack değilse kontrol atlanır.
+func (s *Suite) checkDualStack(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Dual-stack kontrolü için node'ları", err)
+	}
+	dualStack := false
+	for _, node := range nodes.Items {
+		if len(ipFamilies(node.Spec.PodCIDRs)) == 2 {
+			dualStack = true
+			break
+		}
+	}
+	if !dualStack {
+		return nil
+	}
+
+	services, err := s.listServices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Dual-stack kontrolü için Service'leri", err)
+	}
+	for _, service := range services.Items {
+		if service.Spec.ClusterIP == corev1.ClusterIPNone || service.Spec.Type == corev1.ServiceTypeExternalName || service.Spec.IPFamilyPolicy == nil {
+			continue
+		}
+		allocated := ipFamilies(service.Spec.ClusterIPs)
+		switch *service.Spec.IPFamilyPolicy {
+		case corev1.IPFamilyPolicyRequireDualStack, corev1.IPFamilyPolicyPreferDualStack:
+			if len(allocated) != 2 {
+				out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "DualStackNotAllocated", "Service %s namespace %s %s istiyor ancak yalnızca %v adresi almış", service.Name, service.Namespace, *service.Spec.IPFamilyPolicy, service.Spec.ClusterIPs)
+			}
+		case corev1.IPFamilyPolicySingleStack:
+			if len(allocated) != 1 {
+				out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "SingleStackDualAllocated", "Service %s namespace %s SingleStack olduğu halde %v adreslerini almış", service.Name, service.Namespace, service.Spec.ClusterIPs)
+			}
+		}
+		var missing []string
+		for _, family := range service.Spec.IPFamilies {
+			if !allocated[family] {
+				missing = append(missing, string(family))
+			}
+		}
+		if len(missing) > 0 {
+			out.objectf(report.Info, serviceRef(service.Namespace, service.Name), "IPFamilyNotAllocated", "Service %s namespace %s %s ailesini istiyor ancak bu aileden adres almamış", service.Name, service.Namespace, strings.Join(missing, ", "))
+		}
+	}
+
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return checkerrors.ListFailed("Dual-stack kontrolü için pod'ları", err)
+	}
+	singleStackPods := 0
+	for _, pod := range pods.Items {
+		if pod.Spec.HostNetwork {
+			continue
+		}
+		var ips []string
+		for _, podIP := range pod.Status.PodIPs {
+			ips = append(ips, podIP.IP)
+		}
+		if len(ipFamilies(ips)) != 2 {
+			singleStackPods++
+			out.objectf(report.Info, podRef(&pod), "SingleStackPod", "Pod %s namespace %s dual-stack cluster'da yalnızca %v adresini almış", pod.Name, pod.Namespace, ips)
+		}
+	}
+	if singleStackPods > 0 {
+		out.infof("Dual-stack cluster'da %d pod tek IP ailesiyle çalışıyor", singleStackPods)
+	}
+	return nil
+}
+
+// ipFamilies, verilen IP ya da CIDR listesindeki IP ailelerini döndürür.
+func ipFamilies(addresses []string) map[corev1.IPFamily]bool {
+	families := map[corev1.IPFamily]bool{}
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			ip, _, _ = net.ParseCIDR(address)
+		}
+		if ip == nil {
+			continue
+		}
+		if ip.To4() != nil {
+			families[corev1.IPv4Protocol] = true
+		} else {
+			families[corev1.IPv6Protocol] = true
+		}
+	}
+	return families
+}
+
+// checkGateways, gateway.networking.k8s.io CRD'leri kuruluysa Accepted ya da
+// Programmed koşulu False olan Gateway'leri ve backendRefs'leri çözülemeyen
+// HTTPRoute'ları raporlar.
+func (s *Suite) checkGateways(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	gateways, found, err := servedResource(ctx, clientset, "gateway.networking.k8s.io", "gateways", "v1", "v1beta1")
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Gateway API sürümleri alınırken hata oluştu")
+	}
+	if !found {
+		return nil
+	}
+	list, err := listAll(ctx, s.opts, s.dynamic.Resource(gateways).Namespace("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("Gateway'leri", err)
+	}
+	for _, gateway := range list.Items {
+		for _, c := range unstructuredConditions(gateway.Object, "status", "conditions") {
+			if (c.Type == "Accepted" || c.Type == "Programmed") && c.Status != string(metav1.ConditionTrue) {
+				out.objectf(report.Info, report.ResourceRef{Kind: "Gateway", Namespace: gateway.GetNamespace(), Name: gateway.GetName()}, c.Type, "Gateway %s namespace %s %s değil (%s): %s", gateway.GetName(), gateway.GetNamespace(), c.Type, c.Reason, c.Message)
+			}
+		}
+	}
+
+	routes := gateways.GroupVersion().WithResource("httproutes")
+	list, err = listAll(ctx, s.opts, s.dynamic.Resource(routes).Namespace("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("HTTPRoute'ları", err)
+	}
+	for _, route := range list.Items {
+		// Route'un her koşulu için sorunlu Gateway'ler tek bir bulguda toplanır.
+		problems := map[string][]string{}
+		parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+		for _, parent := range parents {
+			fields, ok := parent.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			parentName, _, _ := unstructured.NestedString(fields, "parentRef", "name")
+			for _, c := range unstructuredConditions(fields, "conditions") {
+				if (c.Type == "Accepted" || c.Type == "ResolvedRefs") && c.Status != string(metav1.ConditionTrue) {
+					problems[c.Type] = append(problems[c.Type], fmt.Sprintf("Gateway %s (%s): %s", parentName, c.Reason, c.Message))
+				}
+			}
+		}
+		for _, conditionType := range []string{"Accepted", "ResolvedRefs"} {
+			if len(problems[conditionType]) > 0 {
+				out.objectf(report.Info, report.ResourceRef{Kind: "HTTPRoute", Namespace: route.GetNamespace(), Name: route.GetName()}, conditionType, "HTTPRoute %s namespace %s şu Gateway'ler için %s değil: %s", route.GetName(), route.GetNamespace(), conditionType, strings.Join(problems[conditionType], "; "))
+			}
+		}
+	}
+	return nil
+}
+
+// meshProfile, bir service mesh'in sidecar enjeksiyonunu tanımlayan özellikleridir.
+type meshProfile struct {
+	name           string
+	sidecar        string
+	controlPlaneNS string
+	controlPlane   string
+	enabled        func(ns *corev1.Namespace) bool
+	optedOut       func(pod *corev1.Pod) bool
+}
+
+var meshProfiles = []meshProfile{
+	{
+		name:           "Istio",
+		sidecar:        "istio-proxy",
+		controlPlaneNS: "istio-system",
+		controlPlane:   "istiod",
+		enabled: func(ns *corev1.Namespace) bool {
+			_, revision := ns.Labels["istio.io/rev"]
+			return ns.Labels["istio-injection"] == "enabled" || revision
+		},
+		optedOut: func(pod *corev1.Pod) bool {
+			return pod.Annotations["sidecar.istio.io/inject"] == "false" || pod.Labels["sidecar.istio.io/inject"] == "false"
+		},
+	},
+	{
+		name:           "Linkerd",
+		sidecar:        "linkerd-proxy",
+		controlPlaneNS: "linkerd",
+		controlPlane:   "linkerd-destination",
+		enabled: func(ns *corev1.Namespace) bool {
+			return ns.Annotations["linkerd.io/inject"] == "enabled"
+		},
+		optedOut: func(pod *corev1.Pod) bool {
+			return pod.Annotations["linkerd.io/inject"] == "disabled"
+		},
+	},
+}
+
+// checkServiceMesh, Istio ya da Linkerd enjeksiyonu açık namespace'lerde
+// sidecar'ı eksik olan pod'ları, hazır olmayan sidecar'ları ve control-plane
+// ile sürümü farklı olan proxy'leri bulur; namespace bazında mesh kapsamını raporlar.
+func (s *Suite) checkServiceMesh(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	namespaces, err := s.listNamespaces(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Mesh kontrolü için namespace'leri", err)
+	}
+	for _, mesh := range meshProfiles {
+		controlPlaneVersion := ""
+		deployment, err := clientset.AppsV1().Deployments(mesh.controlPlaneNS).Get(ctx, mesh.controlPlane, metav1.GetOptions{})
+		if err == nil && len(deployment.Spec.Template.Spec.Containers) > 0 {
+			controlPlaneVersion = imageTag(deployment.Spec.Template.Spec.Containers[0].Image)
+		}
+
+		for _, namespace := range namespaces.Items {
+			if !mesh.enabled(&namespace) {
+				continue
+			}
+			pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods(namespace.Name).List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+			if err != nil {
+				out.infof("Namespace %s pod'larını listelerken hata oluştu: %v", namespace.Name, err)
+				continue
+			}
+			meshed := 0
+			for _, pod := range pods.Items {
+				if pod.Spec.HostNetwork || mesh.optedOut(&pod) {
+					continue
+				}
+				sidecar, status := podSidecar(&pod, mesh.sidecar)
+				if sidecar == nil {
+					out.objectf(report.Info, podRef(&pod), "SidecarMissing", "Pod %s namespace %s içinde %s sidecar'ı (%s) eksik", pod.Name, pod.Namespace, mesh.name, mesh.sidecar)
+					continue
+				}
+				meshed++
+				if status != nil && !status.Ready {
+					out.objectf(report.Info, podRef(&pod), "SidecarNotReady", "Pod %s namespace %s içinde %s sidecar'ı hazır değil (%d yeniden başlatma)", pod.Name, pod.Namespace, mesh.sidecar, status.RestartCount)
+				}
+				if version := imageTag(sidecar.Image); controlPlaneVersion != "" && version != controlPlaneVersion {
+					out.objectf(report.Info, podRef(&pod), "SidecarVersionSkew", "Pod %s namespace %s içinde %s sürümü %s, control-plane sürümü %s", pod.Name, pod.Namespace, mesh.sidecar, version, controlPlaneVersion)
+				}
+			}
+			if len(pods.Items) > 0 {
+				out.objectf(report.Info, namespaceRef(namespace.Name), "MeshCoverage", "Namespace %s %s kapsamı: %d/%d pod", namespace.Name, mesh.name, meshed, len(pods.Items))
+			}
+		}
+	}
+	return nil
+}
+
+// podSidecar, pod'daki verilen adlı sidecar container'ını ve durumunu döndürür.
+// Native sidecar olarak init container'larda çalışan proxy'ler de dikkate alınır.
+func podSidecar(pod *corev1.Pod, name string) (*corev1.Container, *corev1.ContainerStatus) {
+	var sidecar *corev1.Container
+	for i := range pod.Spec.Containers {
+		if pod.Spec.Containers[i].Name == name {
+			sidecar = &pod.Spec.Containers[i]
+		}
+	}
+	for i := range pod.Spec.InitContainers {
+		if pod.Spec.InitContainers[i].Name == name {
+			sidecar = &pod.Spec.InitContainers[i]
+		}
+	}
+	if sidecar == nil {
+		return nil, nil
+	}
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.ContainerStatuses...), pod.Status.InitContainerStatuses...)
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return sidecar, &statuses[i]
+		}
+	}
+	return sidecar, nil
+}
+
+// imageTag, image referansının etiketini döndürür; etiket yoksa boş döner.
+func imageTag(image string) string {
+	image = strings.SplitN(image, "@", 2)[0]
+	slash := strings.LastIndex(image, "/")
+	if colon := strings.LastIndex(image, ":"); colon > slash {
+		return image[colon+1:]
+	}
+	return ""
+}
+
+// checkOrphanedEndpoints, sahibi olan Service'i artık bulunmayan Endpoints ve
+// EndpointSlice nesnelerini raporlar. Eski leader-election kayıtları olarak
+// kullanılan Endpoints nesneleri kontrol dışı bırakılır.
+func (s *Suite) checkOrphanedEndpoints(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	services, err := s.listServices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Sahipsiz endpoint kontrolü için Service'leri", err)
+	}
+	existing := map[string]bool{}
+	for _, service := range services.Items {
+		existing[service.Namespace+"/"+service.Name] = true
+	}
+
+	endpoints, err := listAll(ctx, s.opts, clientset.CoreV1().Endpoints("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("Endpoints nesnelerini", err)
+	}
+	orphaned := 0
+	for _, endpoint := range endpoints.Items {
+		if _, leader := endpoint.Annotations["control-plane.alpha.kubernetes.io/leader"]; leader {
+			continue
+		}
+		if !existing[endpoint.Namespace+"/"+endpoint.Name] {
+			orphaned++
+			out.objectf(report.Info, report.ResourceRef{Kind: "Endpoints", Namespace: endpoint.Namespace, Name: endpoint.Name}, "OrphanedEndpoints", "Endpoints %s namespace %s içinde sahibi olan Service bulunamadı", endpoint.Name, endpoint.Namespace)
+		}
+	}
+
+	slices, err := s.listEndpointSlices(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("EndpointSlice'ları", err)
+	}
+	for _, slice := range slices.Items {
+		service, ok := slice.Labels[discoveryv1.LabelServiceName]
+		if !ok {
+			continue
+		}
+		if !existing[slice.Namespace+"/"+service] {
+			orphaned++
+			out.objectf(report.Info, report.ResourceRef{Kind: "EndpointSlice", Namespace: slice.Namespace, Name: slice.Name}, "OrphanedEndpointSlice", "EndpointSlice %s namespace %s içinde sahibi olan Service %s bulunamadı", slice.Name, slice.Namespace, service)
+		}
+	}
+	if orphaned > 0 {
+		out.infof("Cluster'da %d sahipsiz endpoint nesnesi var", orphaned)
+	}
+	return nil
+}
+
+func serviceRef(namespace, name string) report.ResourceRef {
+	return report.ResourceRef{Kind: "Service", Namespace: namespace, Name: name}
+}
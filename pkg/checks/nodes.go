@@ -0,0 +1,705 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+)
+
+// checkNodeNotReadyDuration, node'ların ne kadar süredir NotReady olduğunu
+// döngüler boyunca izler ve kesinti sürdükçe önem derecesini yükseltir:
+// --node-notready-warning sonrasında uyarı, --node-notready-critical
+// sonrasında kritik. Kesinti, Ready koşulunun LastTransitionTime değerinden
+// başlatılır; böylece aracın başlatılmasından önce NotReady olmuş node'lar da
+// gerçek süreleriyle raporlanır. Yeniden Ready olan node'lar kesinti süresiyle
+// birlikte bildirilir.
+func (s *Suite) checkNodeNotReadyDuration(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	seen := map[string]bool{}
+	for _, node := range nodes.Items {
+		seen[node.Name] = true
+		since, tracked := s.nodeNotReadySince[node.Name]
+		if isNodeReady(&node) {
+			if tracked {
+				out.infof("Node %s %s süren kesintinin ardından yeniden Ready", node.Name, time.Since(since).Round(time.Second))
+				delete(s.nodeNotReadySince, node.Name)
+			}
+			continue
+		}
+		if !tracked {
+			since = notReadySince(&node)
+			s.nodeNotReadySince[node.Name] = since
+		}
+		duration := time.Since(since)
+		switch {
+		case duration >= s.opts.NodeNotReadyCritical:
+			out.objectf(report.Critical, nodeRef(node.Name), "NotReady", "Node %s %s süredir NotReady", node.Name, duration.Round(time.Second))
+		case duration >= s.opts.NodeNotReadyWarning:
+			out.objectf(report.Warning, nodeRef(node.Name), "NotReady", "Node %s %s süredir NotReady", node.Name, duration.Round(time.Second))
+		default:
+			out.infof("Node %s NotReady (%s süredir izleniyor)", node.Name, duration.Round(time.Second))
+		}
+	}
+	for name := range s.nodeNotReadySince {
+		if !seen[name] {
+			delete(s.nodeNotReadySince, name)
+		}
+	}
+	return nil
+}
+
+// notReadySince, node'un Ready koşulunun son geçiş zamanını döndürür; koşul
+// ya da geçiş zamanı yoksa şimdiki zamanı döndürür.
+func notReadySince(node *corev1.Node) time.Time {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady && !condition.LastTransitionTime.IsZero() {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return time.Now()
+}
+
+// checkNodeCapacity, her node'a atanmış pod'ların CPU ve bellek isteklerini
+// toplayıp node'un allocatable değerleriyle karşılaştırır. İstekleri
+// --node-request-threshold yüzdesini aşan node'lar raporlanır ve cluster
+// genelinde kalan boş kapasite yazdırılır.
+func (s *Suite) checkNodeCapacity(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Pod'ları", err)
+	}
+	requested := map[string]corev1.ResourceList{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Spec.NodeName == "" || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if requested[pod.Spec.NodeName] == nil {
+			requested[pod.Spec.NodeName] = corev1.ResourceList{}
+		}
+		addResourceList(requested[pod.Spec.NodeName], podRequests(pod))
+	}
+
+	free := corev1.ResourceList{}
+	total := corev1.ResourceList{}
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			allocatable := node.Status.Allocatable[name]
+			used := requested[node.Name][name]
+			addResourceList(total, corev1.ResourceList{name: allocatable})
+			if allocatable.IsZero() {
+				continue
+			}
+			percent := float64(used.MilliValue()) / float64(allocatable.MilliValue()) * 100
+			if percent >= s.opts.NodeRequestThreshold {
+				out.infof("Node %s üzerinde %s istekleri allocatable değerin %%%.0f'ine ulaştı (%s / %s)", node.Name, name, percent, used.String(), allocatable.String())
+			}
+			remaining := allocatable.DeepCopy()
+			remaining.Sub(used)
+			if remaining.Sign() > 0 {
+				addResourceList(free, corev1.ResourceList{name: remaining})
+			}
+		}
+	}
+	cpuFree, cpuTotal := free[corev1.ResourceCPU], total[corev1.ResourceCPU]
+	memoryFree, memoryTotal := free[corev1.ResourceMemory], total[corev1.ResourceMemory]
+	out.infof("Cluster'da boş kapasite: CPU %s / %s, bellek %s / %s", cpuFree.String(), cpuTotal.String(), memoryFree.String(), memoryTotal.String())
+	return nil
+}
+
+// podRequests, pod'un scheduler tarafından hesaba katılan etkin kaynak
+// isteklerini döndürür: container isteklerinin toplamı ile en büyük init
+// container isteğinin büyüğü, artı pod overhead'i.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(requests, container.Resources.Requests)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	addResourceList(requests, pod.Spec.Overhead)
+	return requests
+}
+
+// addResourceList, source içindeki miktarları target'a ekler.
+func addResourceList(target, source corev1.ResourceList) {
+	for name, quantity := range source {
+		if current, ok := target[name]; ok {
+			current.Add(quantity)
+			target[name] = current
+		} else {
+			target[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+// checkCordonedNodes, schedulable olmayan node'ları ne kadar süredir
+// cordon'lu olduklarıyla ve üzerlerinde hâlâ çalışan pod sayısıyla birlikte
+// listeler. --cordon-stale-threshold süresinden uzun süredir cordon'lu olup
+// DaemonSet ve mirror pod'lar dışında pod barındıran node'lar, başlatılıp
+// unutulmuş drain işlemi olarak uyarılır.
+func (s *Suite) checkCordonedNodes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	var cordoned []corev1.Node
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			cordoned = append(cordoned, node)
+		}
+	}
+	if len(cordoned) == 0 {
+		return nil
+	}
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Pod'ları", err)
+	}
+	remaining := map[string]int{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if _, mirror := pod.Annotations[corev1.MirrorPodAnnotationKey]; mirror {
+			continue
+		}
+		if owner := metav1.GetControllerOf(&pod); owner != nil && owner.Kind == "DaemonSet" {
+			continue
+		}
+		remaining[pod.Spec.NodeName]++
+	}
+	for _, node := range cordoned {
+		since := "bilinmeyen bir süredir"
+		var duration time.Duration
+		for _, taint := range node.Spec.Taints {
+			if taint.Key == corev1.TaintNodeUnschedulable && taint.TimeAdded != nil {
+				duration = time.Since(taint.TimeAdded.Time)
+				since = duration.Round(time.Second).String() + " süredir"
+			}
+		}
+		if duration > s.opts.CordonStaleThreshold && remaining[node.Name] > 0 {
+			out.objectf(report.Warning, nodeRef(node.Name), "DrainIncomplete", "Node %s %s cordon'lu ve üzerinde hâlâ %d pod çalışıyor; drain yarıda kalmış olabilir", node.Name, since, remaining[node.Name])
+			continue
+		}
+		out.infof("Node %s %s cordon'lu, üzerinde %d pod çalışıyor", node.Name, since, remaining[node.Name])
+	}
+	return nil
+}
+
+// checkTaints, cluster'daki node taint'lerini özetler ve yalnızca tolere
+// etmedikleri taint'ler yüzünden schedule edilemeyen Pending pod'ları bulur.
+// nodeSelector'ı ve zorunlu node affinity'sini
+// (requiredDuringSchedulingIgnoredDuringExecution) karşılayan her node tolere
+// edilmeyen bir NoSchedule/NoExecute taint'i taşıyorsa, pod için en az eksik
+// toleration'a sahip node önerilir.
+func (s *Suite) checkTaints(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	taintedNodes := map[string][]string{}
+	for _, node := range nodes.Items {
+		for _, taint := range node.Spec.Taints {
+			taintedNodes[taint.ToString()] = append(taintedNodes[taint.ToString()], node.Name)
+		}
+	}
+	taints := make([]string, 0, len(taintedNodes))
+	for taint := range taintedNodes {
+		taints = append(taints, taint)
+	}
+	sort.Strings(taints)
+	for _, taint := range taints {
+		out.infof("Taint %s %d node üzerinde: %s", taint, len(taintedNodes[taint]), strings.Join(taintedNodes[taint], ", "))
+	}
+
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Pending,spec.nodeName="})
+	if err != nil {
+		return checkerrors.ListFailed("Pending pod'ları", err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		affinity := nodeaffinity.GetRequiredNodeAffinity(pod)
+		var closestNode string
+		var closestMissing []corev1.Taint
+		blocked := false
+		for _, node := range nodes.Items {
+			if node.Spec.Unschedulable {
+				continue
+			}
+			if matches, err := affinity.Match(&node); err != nil || !matches {
+				continue
+			}
+			missing := untoleratedTaints(pod, &node)
+			if len(missing) == 0 {
+				blocked = false
+				break
+			}
+			blocked = true
+			if closestNode == "" || len(missing) < len(closestMissing) {
+				closestNode, closestMissing = node.Name, missing
+			}
+		}
+		if !blocked {
+			continue
+		}
+		var suggestions []string
+		for _, taint := range closestMissing {
+			suggestions = append(suggestions, taint.ToString())
+		}
+		out.infof("Pod %s namespace %s yalnızca taint'ler nedeniyle schedule edilemiyor; en yakın node %s için eksik toleration: %s", pod.Name, pod.Namespace, closestNode, strings.Join(suggestions, ", "))
+	}
+	return nil
+}
+
+// untoleratedTaints, node'un pod tarafından tolere edilmeyen NoSchedule ve
+// NoExecute taint'lerini döndürür.
+func untoleratedTaints(pod *corev1.Pod, node *corev1.Node) []corev1.Taint {
+	var missing []corev1.Taint
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+		tolerated := false
+		for _, toleration := range pod.Spec.Tolerations {
+			if toleration.ToleratesTaint(&taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			missing = append(missing, taint)
+		}
+	}
+	return missing
+}
+
+// checkVersionSkew, her node'un kubelet sürümünü API server sürümüyle
+// karşılaştırır. API server'dan yeni olan ya da --kubelet-max-skew minor
+// sürümden fazla geride kalan kubelet'ler kritik olarak raporlanır; node'lar
+// arasında birden fazla kubelet sürümü varsa dağılım yazdırılır.
+func (s *Suite) checkVersionSkew(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	serverInfo, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Cluster sürümü alınırken hata oluştu")
+	}
+	serverVersion, err := version.ParseGeneric(serverInfo.GitVersion)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Cluster sürümü %q çözümlenemedi", serverInfo.GitVersion)
+	}
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	pools := map[string][]string{}
+	for _, node := range nodes.Items {
+		kubeletVersion := node.Status.NodeInfo.KubeletVersion
+		pools[kubeletVersion] = append(pools[kubeletVersion], node.Name)
+		parsed, err := version.ParseGeneric(kubeletVersion)
+		if err != nil {
+			out.infof("Node %s kubelet sürümü %q çözümlenemedi: %v", node.Name, kubeletVersion, err)
+			continue
+		}
+		skew := int(serverVersion.Minor()) - int(parsed.Minor())
+		switch {
+		case parsed.Major() != serverVersion.Major() || skew < 0:
+			out.objectf(report.Critical, nodeRef(node.Name), "KubeletVersionSkew", "Node %s kubelet %s API server %s sürümünden yeni", node.Name, kubeletVersion, serverInfo.GitVersion)
+		case skew > s.opts.KubeletMaxSkew:
+			out.objectf(report.Critical, nodeRef(node.Name), "KubeletVersionSkew", "Node %s kubelet %s API server %s sürümünden %d minor sürüm geride (desteklenen: %d)", node.Name, kubeletVersion, serverInfo.GitVersion, skew, s.opts.KubeletMaxSkew)
+		}
+	}
+	if len(pools) > 1 {
+		versions := make([]string, 0, len(pools))
+		for kubeletVersion := range pools {
+			versions = append(versions, kubeletVersion)
+		}
+		sort.Strings(versions)
+		out.infof("Node'larda %d farklı kubelet sürümü çalışıyor:", len(versions))
+		for _, kubeletVersion := range versions {
+			out.infof("  %s: %d node (%s)", kubeletVersion, len(pools[kubeletVersion]), strings.Join(pools[kubeletVersion], ", "))
+		}
+	}
+	return nil
+}
+
+// checkNodeInventoryDrift, node'ların nodeInfo alanlarından (işletim sistemi
+// imajı, kernel ve container runtime sürümü) bir envanter çıkarır ve her alan
+// için filodaki en yaygın değerden farklı olan node'ları raporlar. Windows ve
+// Linux gibi farklı platformlar birbirleriyle karşılaştırılmaz.
+func (s *Suite) checkNodeInventoryDrift(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	fields := []struct {
+		name  string
+		value func(info corev1.NodeSystemInfo) string
+	}{
+		{"OS imajı", func(info corev1.NodeSystemInfo) string { return info.OSImage }},
+		{"kernel", func(info corev1.NodeSystemInfo) string { return info.KernelVersion }},
+		{"container runtime", func(info corev1.NodeSystemInfo) string { return info.ContainerRuntimeVersion }},
+	}
+	platforms := map[string][]corev1.Node{}
+	for _, node := range nodes.Items {
+		platform := node.Status.NodeInfo.OperatingSystem + "/" + node.Status.NodeInfo.Architecture
+		platforms[platform] = append(platforms[platform], node)
+	}
+	platformNames := make([]string, 0, len(platforms))
+	for platform := range platforms {
+		platformNames = append(platformNames, platform)
+	}
+	sort.Strings(platformNames)
+	for _, platform := range platformNames {
+		platformNodes := platforms[platform]
+		for _, field := range fields {
+			counts := map[string]int{}
+			for _, node := range platformNodes {
+				counts[field.value(node.Status.NodeInfo)]++
+			}
+			values := make([]string, 0, len(counts))
+			for value := range counts {
+				values = append(values, value)
+			}
+			sort.Strings(values)
+			// Eşitlik durumunda alfabetik olarak ilk değer çoğunluk sayılır.
+			baseline := values[0]
+			for _, value := range values[1:] {
+				if counts[value] > counts[baseline] {
+					baseline = value
+				}
+			}
+			out.infof("%s node'larında %s: %s (%d/%d node)", platform, field.name, baseline, counts[baseline], len(platformNodes))
+			for _, node := range platformNodes {
+				if value := field.value(node.Status.NodeInfo); value != baseline {
+					out.infof("Node %s %s değeri filodan farklı: %s (çoğunluk: %s)", node.Name, field.name, value, baseline)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkNodeLeases, kube-node-lease namespace'indeki Lease'lerin renewTime
+// değerlerini kontrol eder. Kubelet, Lease'i leaseDurationSeconds süresi
+// içinde yenilemediğinde node henüz NotReady olmasa bile uyarı verilir; bu,
+// node controller'ın koşulları değiştirmesinden daha erken bir sinyaldir.
+func (s *Suite) checkNodeLeases(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	leases, err := listAll(ctx, s.opts, clientset.CoordinationV1().Leases(corev1.NamespaceNodeLease).List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("Node Lease'lerini", err)
+	}
+	renewed := map[string]time.Time{}
+	durations := map[string]time.Duration{}
+	for _, lease := range leases.Items {
+		if lease.Spec.RenewTime != nil {
+			renewed[lease.Name] = lease.Spec.RenewTime.Time
+		}
+		durations[lease.Name] = 40 * time.Second
+		if lease.Spec.LeaseDurationSeconds != nil {
+			durations[lease.Name] = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+		}
+	}
+	for _, node := range nodes.Items {
+		renewTime, ok := renewed[node.Name]
+		if !ok {
+			out.infof("Node %s için kube-node-lease içinde yenilenmiş Lease bulunamadı", node.Name)
+			continue
+		}
+		if age := time.Since(renewTime); age > durations[node.Name] {
+			ready := "Ready"
+			if !isNodeReady(&node) {
+				ready = "NotReady"
+			}
+			out.objectf(report.Warning, nodeRef(node.Name), "LeaseNotRenewed", "Node %s Lease'i %s önce yenilendi (süre: %s, node durumu: %s)", node.Name, age.Round(time.Second), durations[node.Name], ready)
+		}
+	}
+	return nil
+}
+
+// spotNodeLabels, node'un spot/preemptible kapasite üzerinde çalıştığını
+// gösteren cloud sağlayıcı etiketleridir.
+var spotNodeLabels = map[string]string{
+	"eks.amazonaws.com/capacityType":        "SPOT",
+	"karpenter.sh/capacity-type":            "spot",
+	"cloud.google.com/gke-spot":             "true",
+	"cloud.google.com/gke-preemptible":      "true",
+	"kubernetes.azure.com/scalesetpriority": "spot",
+}
+
+// interruptionTaints, node'un yakında sonlandırılacağını bildiren taint
+// anahtarlarıdır (AWS Node Termination Handler, Karpenter ve GKE).
+var interruptionTaints = []string{
+	"aws-node-termination-handler/spot-itn",
+	"aws-node-termination-handler/rebalance-recommendation",
+	"aws-node-termination-handler/scheduled-maintenance",
+	"karpenter.sh/disrupted",
+	"karpenter.sh/disruption",
+	"cloud.google.com/impending-node-termination",
+}
+
+// interruptionEventReasons, spot kesintilerini ve sonlandırma bildirimlerini
+// kaydeden event nedenleridir.
+var interruptionEventReasons = map[string]bool{
+	"SpotInterruption":        true,
+	"SpotInterrupted":         true,
+	"RebalanceRecommendation": true,
+	"ScheduledEvent":          true,
+	"PreemptScheduled":        true,
+	"Preempted":               true,
+}
+
+// checkSpotInterruptions, spot/preemptible node'ları sayar, yakın bir
+// kesinti için taint'lenmiş node'ları ve bu node'larda çalışan, kesintiden
+// etkilenecek iş yüklerini raporlar. Kesinti event'leri nedenlerine göre sayılır.
+func (s *Suite) checkSpotInterruptions(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	spotNodes := 0
+	interrupted := map[string]string{}
+	for _, node := range nodes.Items {
+		for key, value := range spotNodeLabels {
+			if strings.EqualFold(node.Labels[key], value) {
+				spotNodes++
+				break
+			}
+		}
+		for _, taint := range node.Spec.Taints {
+			if containsString(interruptionTaints, taint.Key) {
+				interrupted[node.Name] = taint.Key
+			}
+		}
+	}
+	if spotNodes > 0 {
+		out.infof("Cluster'da %d spot/preemptible node var", spotNodes)
+	}
+
+	if len(interrupted) > 0 {
+		pods, err := s.listPods(ctx, clientset, "")
+		if err != nil {
+			return checkerrors.ListFailed("Pod'ları", err)
+		}
+		replicaSets, err := s.listReplicaSets(ctx, clientset, "")
+		if err != nil {
+			return checkerrors.ListFailed("ReplicaSet'leri", err)
+		}
+		rsOwners := replicaSetOwners(replicaSets.Items)
+		affected := map[string][]string{}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if _, ok := interrupted[pod.Spec.NodeName]; !ok || pod.Status.Phase != corev1.PodRunning {
+				continue
+			}
+			workload := podWorkload(pod, rsOwners)
+			if workload == "" {
+				workload = "Pod " + pod.Namespace + "/" + pod.Name
+			}
+			if !strings.HasPrefix(workload, "DaemonSet ") {
+				affected[pod.Spec.NodeName] = append(affected[pod.Spec.NodeName], workload)
+			}
+		}
+		nodeNames := make([]string, 0, len(interrupted))
+		for nodeName := range interrupted {
+			nodeNames = append(nodeNames, nodeName)
+		}
+		sort.Strings(nodeNames)
+		for _, nodeName := range nodeNames {
+			out.objectf(report.Warning, nodeRef(nodeName), "NodeInterruptionPending", "Node %s yakında sonlandırılacak (%s); etkilenecek %d iş yükü: %s", nodeName, interrupted[nodeName], len(affected[nodeName]), strings.Join(affected[nodeName], ", "))
+		}
+	}
+
+	reasons := make([]string, 0, len(interruptionEventReasons))
+	for reason := range interruptionEventReasons {
+		reasons = append(reasons, reason)
+	}
+	events, err := s.listEvents(ctx, clientset, "", reasons...)
+	if err != nil {
+		return checkerrors.ListFailed("Event'leri", err)
+	}
+	counts := map[string]int32{}
+	for _, event := range events {
+		if interruptionEventReasons[event.Reason] {
+			count := event.Count
+			if count == 0 {
+				count = 1
+			}
+			counts[event.Reason] += count
+		}
+	}
+	countedReasons := make([]string, 0, len(counts))
+	for reason := range counts {
+		countedReasons = append(countedReasons, reason)
+	}
+	sort.Strings(countedReasons)
+	for _, reason := range countedReasons {
+		out.infof("Son kesinti event'leri: %s %d kez", reason, counts[reason])
+	}
+	return nil
+}
+
+// checkNodeDiskUsage, her node'un kubelet /stats/summary çıktısından nodefs
+// ve imagefs doluluğunu ve inode kullanımını okur. Kubelet varsayılan
+// eviction eşiklerine (nodefs için %90, imagefs için %85 doluluk) ulaşmadan
+// önce uyarı vermek için --node-disk-threshold ve --node-inode-threshold kullanılır.
+func (s *Suite) checkNodeDiskUsage(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	for _, node := range nodes.Items {
+		if !isNodeReady(&node) {
+			continue
+		}
+		summary, err := getKubeletSummary(ctx, clientset, node.Name)
+		if err != nil {
+			out.infof("Node %s için kubelet istatistikleri alınırken hata oluştu: %v", node.Name, err)
+			continue
+		}
+		filesystems := map[string]*kubeletFsStats{"nodefs": summary.Node.Fs}
+		if summary.Node.Runtime != nil {
+			filesystems["imagefs"] = summary.Node.Runtime.ImageFs
+		}
+		var disk, inodes []string
+		for _, name := range []string{"nodefs", "imagefs"} {
+			fs := filesystems[name]
+			if fs == nil {
+				continue
+			}
+			if fs.UsedBytes != nil && fs.CapacityBytes != nil && *fs.CapacityBytes > 0 {
+				usage := float64(*fs.UsedBytes) / float64(*fs.CapacityBytes) * 100
+				if usage >= s.opts.NodeDiskThreshold {
+					disk = append(disk, fmt.Sprintf("%s %%%.0f", name, usage))
+				}
+			}
+			if fs.InodesUsed != nil && fs.Inodes != nil && *fs.Inodes > 0 {
+				usage := float64(*fs.InodesUsed) / float64(*fs.Inodes) * 100
+				if usage >= s.opts.NodeInodeThreshold {
+					inodes = append(inodes, fmt.Sprintf("%s %%%.0f", name, usage))
+				}
+			}
+		}
+		if len(disk) > 0 {
+			out.objectf(report.Warning, nodeRef(node.Name), "NodeDiskUsageHigh", "Node %s dosya sistemlerinin doluluk oranı yüksek: %s", node.Name, strings.Join(disk, ", "))
+		}
+		if len(inodes) > 0 {
+			out.objectf(report.Warning, nodeRef(node.Name), "NodeInodeUsageHigh", "Node %s dosya sistemlerinin inode kullanımı yüksek: %s", node.Name, strings.Join(inodes, ", "))
+		}
+	}
+	return nil
+}
+
+// checkClockSkew, API server'ın ve her node'daki kubelet'in HTTP Date
+// başlığını bu aracın saatiyle karşılaştırır; --clock-skew-threshold
+// değerinden fazla sapan saatler TLS doğrulamasını ve Lease'leri bozduğu
+// için uyarılır. Date başlığı saniye çözünürlüğünde olduğundan eşik birkaç
+// saniyeden küçük tutulmamalıdır. Kubelet'e ulaşılamayan node'larda Lease
+// renewTime değerinin gelecekte olması da saat sapması olarak raporlanır.
+func (s *Suite) checkClockSkew(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	httpClient, err := rest.HTTPClientFor(s.config)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Saat sapması kontrolü için HTTP istemcisi oluşturulamadı")
+	}
+	host := strings.TrimSuffix(s.config.Host, "/")
+	if offset, err := clockOffset(ctx, httpClient, host+"/version"); err != nil {
+		out.infof("API server saati okunurken hata oluştu: %v", err)
+	} else if offset > s.opts.ClockSkewThreshold || offset < -s.opts.ClockSkewThreshold {
+		out.warningf("API server saati bu aracın saatinden %s sapıyor", offset.Round(time.Second))
+	}
+
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Node'ları", err)
+	}
+	leases, err := listAll(ctx, s.opts, clientset.CoordinationV1().Leases(corev1.NamespaceNodeLease).List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("Node Lease'lerini", err)
+	}
+	renewed := map[string]time.Time{}
+	for _, lease := range leases.Items {
+		if lease.Spec.RenewTime != nil {
+			renewed[lease.Name] = lease.Spec.RenewTime.Time
+		}
+	}
+	for _, node := range nodes.Items {
+		if !isNodeReady(&node) {
+			continue
+		}
+		offset, err := clockOffset(ctx, httpClient, host+"/api/v1/nodes/"+node.Name+"/proxy/healthz")
+		if err != nil {
+			renewTime, ok := renewed[node.Name]
+			if ok && time.Until(renewTime) > s.opts.ClockSkewThreshold {
+				out.objectf(report.Warning, nodeRef(node.Name), "ClockSkew", "Node %s Lease renewTime değeri %s ileride; node saati ileri olabilir", node.Name, time.Until(renewTime).Round(time.Second))
+			}
+			continue
+		}
+		if offset > s.opts.ClockSkewThreshold || offset < -s.opts.ClockSkewThreshold {
+			out.objectf(report.Warning, nodeRef(node.Name), "ClockSkew", "Node %s saati bu aracın saatinden %s sapıyor", node.Name, offset.Round(time.Second))
+		}
+	}
+	return nil
+}
+
+// clockOffset, verilen URL'ye yapılan isteğin Date başlığını isteğin
+// gönderilme ve yanıt alınma zamanlarının ortasıyla karşılaştırarak sunucu
+// saatinin yerel saate göre farkını döndürür.
+func clockOffset(ctx context.Context, httpClient *http.Client, url string) (time.Duration, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	response.Body.Close()
+	end := time.Now()
+	// Hata yanıtları proxy'den geldiğinden Date başlığı hedefin saatini yansıtmaz.
+	if response.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("beklenmeyen durum kodu: %s", response.Status)
+	}
+	date, err := http.ParseTime(response.Header.Get("Date"))
+	if err != nil {
+		return 0, fmt.Errorf("geçersiz Date başlığı %q: %v", response.Header.Get("Date"), err)
+	}
+	// Date başlığı saniyeye yuvarlandığı için ortalama yarım saniyelik kayıp eklenir.
+	midpoint := start.Add(end.Sub(start) / 2)
+	return date.Add(500 * time.Millisecond).Sub(midpoint), nil
+}
+
+// nodeRef, bulgularda kullanılmak üzere bir Node'un ResourceRef'ini döndürür.
+func nodeRef(name string) report.ResourceRef {
+	return report.ResourceRef{Kind: "Node", Name: name}
+}
@@ -0,0 +1,81 @@
+package checks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodeNotReadyDurationUsesLastTransitionTime(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-1"},
+		Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+			Type: corev1.NodeReady, Status: corev1.ConditionFalse, LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+		}}},
+	}
+	clientset := fake.NewSimpleClientset(node)
+	opts := DefaultOptions()
+	opts.NodeNotReadyWarning = 10 * time.Minute
+	opts.NodeNotReadyCritical = time.Hour
+	s := &Suite{opts: opts, nodeNotReadySince: map[string]time.Time{}}
+	out := &findings{check: "node-notready-duration"}
+	if err := s.checkNodeNotReadyDuration(context.Background(), clientset, out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.list) != 1 || out.list[0].Severity != report.Critical {
+		t.Fatalf("iki saattir NotReady olan node ilk döngüde kritik raporlanmalı: %+v", out.list)
+	}
+}
+
+func TestTaintsRespectRequiredNodeAffinity(t *testing.T) {
+	affinity := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"gpu"}}},
+		}}},
+	}}
+	tainted := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "gpu-1", Labels: map[string]string{"pool": "gpu"}},
+		Spec:       corev1.NodeSpec{Taints: []corev1.Taint{{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoSchedule}}},
+	}
+	untainted := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "general-1", Labels: map[string]string{"pool": "general"}}}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "trainer"},
+		Spec:       corev1.PodSpec{Affinity: affinity},
+		Status:     corev1.PodStatus{Phase: corev1.PodPending},
+	}
+
+	for _, test := range []struct {
+		nodes   []*corev1.Node
+		blocked bool
+	}{
+		// Taint'siz node affinity'yi karşılamadığından pod'u yalnızca taint engelliyor.
+		{[]*corev1.Node{tainted, untainted}, true},
+		// Affinity'yi karşılayan hiç node yoksa sorun taint'ler değildir.
+		{[]*corev1.Node{untainted}, false},
+	} {
+		clientset := fake.NewSimpleClientset(pod)
+		for _, node := range test.nodes {
+			if _, err := clientset.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		s := &Suite{opts: DefaultOptions()}
+		out := &findings{check: "taints"}
+		if err := s.checkTaints(context.Background(), clientset, out); err != nil {
+			t.Fatal(err)
+		}
+		blocked := false
+		for _, finding := range out.list {
+			blocked = blocked || strings.Contains(finding.Message, "yalnızca taint'ler nedeniyle")
+		}
+		if blocked != test.blocked {
+			t.Errorf("%d node için taint engeli %v beklenirken %v: %+v", len(test.nodes), test.blocked, blocked, out.list)
+		}
+	}
+}
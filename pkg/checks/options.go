@@ -0,0 +1,201 @@
+package checks
+
+import (
+	"flag"
+	"time"
+)
+
+// Options, yerleşik kontrollerin yapılandırılabilir eşiklerini ve
+// davranışlarını tutar. Her alanın bir komut satırı flag'i karşılığı vardır.
+type Options struct {
+	// Depolama kontrolleri.
+	UnboundPVThreshold            time.Duration
+	VolumeUsageThreshold          float64
+	SnapshotStuckThreshold        time.Duration
+	ResizeStuckThreshold          time.Duration
+	BlockOnlyProvisioners         string
+	DeleteOrphanedStatefulSetPVCs bool
+	AttachmentStuckThreshold      time.Duration
+	HostPathAllowedNamespaces     string
+	MemoryConstrainedNode         string
+	StorageQuotaThreshold         float64
+
+	// Güvenlik kontrolleri.
+	UnusedConfigMinAge            time.Duration
+	UnusedConfigExcludeNamespaces string
+	HostNamespaceExcluded         string
+	HostNamespaceJustification    string
+	TrivyScan                     bool
+	TrivyBinary                   string
+	TrivyCacheTTL                 time.Duration
+	TrivyScanTimeout              time.Duration
+	ClusterAdminAllowedSubjects   string
+	RBACSkipSystemRoles           bool
+	DangerousCapabilities         string
+	AllowedRegistries             string
+	GatekeeperSeverities          string
+
+	// Sertifika kontrolleri.
+	CertExpiryWindow          time.Duration
+	CertManagerStuckThreshold time.Duration
+	CSRPendingThreshold       time.Duration
+
+	// Control-plane kontrolleri.
+	APILatencyWindow        int
+	APILatencyThreshold     time.Duration
+	EtcdQuotaBytes          int64
+	EtcdMetricsPort         int
+	EtcdDBThreshold         float64
+	EOLWarningWindow        time.Duration
+	Environment             string
+	EnvironmentTargets      string
+	ControlPlaneComponents  string
+	TargetKubernetesVersion string
+	KubeSystemAddons        string
+
+	// İş yükü kontrolleri.
+	CompletedPodThreshold     int
+	CompletedPodTTL           time.Duration
+	RolloutWindow             time.Duration
+	EphemeralStorageThreshold float64
+
+	// Ağ kontrolleri.
+	DNSProbe                     bool
+	DNSProbeNames                string
+	DNSProbeTimeout              time.Duration
+	ClusterDomain                string
+	NodePortRange                string
+	NodePortUsageThreshold       float64
+	EndpointStaleThreshold       time.Duration
+	ServiceProxyDaemonSets       string
+	LoadBalancerPendingThreshold time.Duration
+
+	// Bağlantı probe'ları.
+	ConnectivityProbe bool
+	ProbeNamespace    string
+	ProbeImage        string
+	ProbeEgressURL    string
+	ProbeCount        int
+	ProbeTimeout      time.Duration
+
+	// Node kontrolleri.
+	NodeNotReadyWarning  time.Duration
+	NodeNotReadyCritical time.Duration
+	NodeDiskThreshold    float64
+	NodeInodeThreshold   float64
+	NodeRequestThreshold float64
+	CordonStaleThreshold time.Duration
+	KubeletMaxSkew       int
+	ClockSkewThreshold   time.Duration
+
+	// Autoscaler ve scheduling kontrolleri.
+	UnschedulablePodThreshold time.Duration
+	UnschedulableTrendCycles  int
+
+	// Cihaz eklentisi kontrolleri.
+	ExtendedResources string
+	GPUNodeSelector   string
+
+	// metrics-server kontrolleri.
+	MemoryLimitThreshold float64
+	CPULimitThreshold    float64
+
+	// API istemci davranışı.
+	PageSize        int64
+	RetryAttempts   int
+	RetryBackoff    time.Duration
+	EventBufferSize int
+
+	// Döngü davranışı.
+	CheckTTLs  string
+	Shards     int
+	ShardIndex int
+}
+
+// DefaultOptions, flag'lerin varsayılan değerleriyle doldurulmuş Options döndürür.
+func DefaultOptions() Options {
+	var options Options
+	options.AddFlags(flag.NewFlagSet("", flag.ContinueOnError))
+	return options
+}
+
+// AddFlags, seçenekleri verilen FlagSet'e varsayılan değerleriyle kaydeder.
+func (o *Options) AddFlags(fs *flag.FlagSet) {
+	fs.DurationVar(&o.UnboundPVThreshold, "unbound-pv-threshold", 7*24*time.Hour, "Available ya da Released PV'lerin unutulmuş sayılmadan önce bekleyebileceği süre")
+	fs.Float64Var(&o.VolumeUsageThreshold, "volume-usage-threshold", 85, "PVC dosya sisteminin yüzde kaçı dolduğunda uyarı verileceği")
+	fs.DurationVar(&o.SnapshotStuckThreshold, "snapshot-stuck-threshold", 30*time.Minute, "VolumeSnapshot'ların hazır olmadan bekleyebileceği süre")
+	fs.DurationVar(&o.ResizeStuckThreshold, "resize-stuck-threshold", 30*time.Minute, "PVC genişletme işleminin takılmış sayılmadan önce sürebileceği süre")
+	fs.StringVar(&o.BlockOnlyProvisioners, "block-only-provisioners", "ebs.csi.aws.com,pd.csi.storage.gke.io,disk.csi.azure.com,kubernetes.io/aws-ebs,kubernetes.io/gce-pd,kubernetes.io/azure-disk", "ReadWriteMany desteklemeyen provisioner'lar")
+	fs.BoolVar(&o.DeleteOrphanedStatefulSetPVCs, "delete-orphaned-statefulset-pvcs", false, "StatefulSet'i silinmiş ve hiçbir pod tarafından kullanılmayan PVC'leri sil; küçültülmüş StatefulSet'lerin PVC'leri silinmez")
+	fs.DurationVar(&o.AttachmentStuckThreshold, "attachment-stuck-threshold", 10*time.Minute, "VolumeAttachment'ların bağlanma/ayrılma için bekleyebileceği süre")
+	fs.StringVar(&o.HostPathAllowedNamespaces, "hostpath-allowed-namespaces", "kube-system", "hostPath volume kullanımına izin verilen namespace'ler")
+	fs.StringVar(&o.MemoryConstrainedNode, "memory-constrained-node", "8Gi", "allocatable belleği bu değerin altında kalan node'lar bellek kısıtlı sayılır")
+	fs.Float64Var(&o.StorageQuotaThreshold, "storage-quota-threshold", 80, "namespace depolama kotasının yüzde kaçı dolduğunda uyarı verileceği")
+	fs.DurationVar(&o.UnusedConfigMinAge, "unused-config-min-age", 24*time.Hour, "kullanılmayan ConfigMap/Secret olarak raporlanmak için gereken en az yaş")
+	fs.StringVar(&o.UnusedConfigExcludeNamespaces, "unused-config-exclude-namespaces", "kube-system,kube-public,kube-node-lease", "kullanılmayan ConfigMap/Secret denetiminin dışında tutulan namespace'ler")
+	fs.StringVar(&o.HostNamespaceExcluded, "host-namespace-excluded", "kube-system", "host namespace denetiminin dışında tutulan namespace'ler")
+	fs.StringVar(&o.HostNamespaceJustification, "host-namespace-justification-annotation", "security.kubernetes.io/justification", "host namespace kullanımının gerekçesini içeren annotation")
+	fs.BoolVar(&o.TrivyScan, "trivy-scan", false, "cluster'da çalışan benzersiz image'ları yerel trivy ikili dosyasıyla tara")
+	fs.StringVar(&o.TrivyBinary, "trivy-binary", "trivy", "image taraması için kullanılacak trivy ikili dosyası")
+	fs.DurationVar(&o.TrivyCacheTTL, "trivy-cache-ttl", 24*time.Hour, "bir image digest'inin trivy tarama sonucunun yeniden taranmadan kullanılacağı süre")
+	fs.DurationVar(&o.TrivyScanTimeout, "trivy-scan-timeout", 10*time.Minute, "arka planda yapılan tek bir trivy taramasının sürebileceği en uzun süre")
+	fs.StringVar(&o.ClusterAdminAllowedSubjects, "cluster-admin-allowed-subjects", "Group:system:masters", "cluster-admin yetkisine sahip olmasına izin verilen subject'ler (Tür:ad ya da ServiceAccount:namespace/ad)")
+	fs.BoolVar(&o.RBACSkipSystemRoles, "rbac-skip-system-roles", true, "system: önekli yerleşik rolleri RBAC wildcard denetiminin dışında tut")
+	fs.StringVar(&o.DangerousCapabilities, "dangerous-capabilities", "NET_ADMIN,SYS_ADMIN,SYS_PTRACE", "eklenmesi raporlanacak Linux yetkileri")
+	fs.StringVar(&o.AllowedRegistries, "allowed-registries", "", "izin verilen registry ya da repository önekleri (ör. registry.k8s.io,docker.io/library); boşsa kontrol yapılmaz")
+	fs.StringVar(&o.GatekeeperSeverities, "gatekeeper-severity", "", "constraint türü ya da adı başına önem derecesi (ör. K8sRequiredLabels=warning,block-privileged=critical)")
+	fs.DurationVar(&o.CertExpiryWindow, "cert-expiry-window", 30*24*time.Hour, "sertifikanın bitiş tarihine bu süreden az kaldığında uyarı verilir")
+	fs.DurationVar(&o.CertManagerStuckThreshold, "cert-manager-stuck-threshold", time.Hour, "cert-manager Order/Challenge nesnelerinin takılmış sayılmadan önce bekleyebileceği süre")
+	fs.DurationVar(&o.CSRPendingThreshold, "csr-pending-threshold", 10*time.Minute, "CertificateSigningRequest'lerin onaysız bekleyebileceği süre")
+	fs.IntVar(&o.APILatencyWindow, "api-latency-window", 30, "API gecikme yüzdelikleri hesaplanırken saklanacak döngü başına ölçüm sayısı")
+	fs.DurationVar(&o.APILatencyThreshold, "api-latency-threshold", time.Second, "p95 API gecikmesinin uyarı verilmeden önce ulaşabileceği süre")
+	fs.Int64Var(&o.EtcdQuotaBytes, "etcd-quota-bytes", 2*1024*1024*1024, "etcd pod komutunda --quota-backend-bytes yoksa kullanılacak veritabanı kotası")
+	fs.IntVar(&o.EtcdMetricsPort, "etcd-metrics-port", 2381, "etcd pod'larının metrics uç noktasını sunduğu port")
+	fs.Float64Var(&o.EtcdDBThreshold, "etcd-db-threshold", 80, "etcd veritabanının kotanın yüzde kaçına ulaştığında uyarı verileceği")
+	fs.DurationVar(&o.EOLWarningWindow, "eol-warning-window", 90*24*time.Hour, "destek bitişine bu süreden az kaldığında uyarı verilir")
+	fs.StringVar(&o.Environment, "environment", "", "cluster'ın ait olduğu ortam adı (ör. prod); --environment-target-versions ile birlikte kullanılır")
+	fs.StringVar(&o.EnvironmentTargets, "environment-target-versions", "", "ortam başına hedef Kubernetes minor sürümleri (ör. prod=1.29,staging=1.30)")
+	fs.StringVar(&o.ControlPlaneComponents, "control-plane-components", "kube-apiserver,kube-controller-manager,kube-scheduler,etcd", "her control-plane node'unda mirror pod olarak beklenen bileşenler")
+	fs.StringVar(&o.TargetKubernetesVersion, "target-kubernetes-version", "", "yükseltme hedefi olan Kubernetes minor sürümü (ör. 1.29); boşsa mevcut sürümden iki sonrası kullanılır")
+	fs.StringVar(&o.KubeSystemAddons, "kube-system-addons", "coredns|kube-dns,kube-proxy|cilium,calico-node|cilium|aws-node|kube-flannel-ds|weave-net|kindnet|antrea-agent,metrics-server,?cloud-controller-manager|aws-cloud-controller-manager|gce-cloud-controller-manager|azure-cloud-node-manager", "beklenen eklentilerin virgülle ayrılmış listesi; her eleman | ile ayrılmış Deployment/DaemonSet adı alternatiflerinden oluşur, ? ile başlayanlar yalnızca kuruluysa denetlenir")
+	fs.IntVar(&o.CompletedPodThreshold, "completed-pod-threshold", 50, "bir namespace'te uyarı verilecek Succeeded/Failed pod sayısı")
+	fs.DurationVar(&o.CompletedPodTTL, "completed-pod-ttl", 0, "bu süreden eski Succeeded/Failed pod'ları sil (0 ise temizlik yapılmaz)")
+	fs.DurationVar(&o.RolloutWindow, "rollout-window", 15*time.Minute, "bir iş yükünün pod'larında farklı image'ların birlikte çalışmasına izin verilen süre")
+	fs.Float64Var(&o.EphemeralStorageThreshold, "ephemeral-storage-threshold", 80, "ephemeral-storage limitinin yüzde kaçı kullanıldığında uyarı verileceği")
+	fs.BoolVar(&o.DNSProbe, "dns-probe", false, "kube-dns Service'i üzerinden gerçek DNS sorguları yap (cluster içinde çalışırken)")
+	fs.StringVar(&o.DNSProbeNames, "dns-probe-names", "kubernetes.default.svc.cluster.local,example.com", "DNS probu sırasında çözülecek adlar")
+	fs.DurationVar(&o.DNSProbeTimeout, "dns-probe-timeout", 2*time.Second, "her DNS sorgusu için zaman aşımı")
+	fs.StringVar(&o.ClusterDomain, "cluster-domain", "cluster.local", "cluster DNS alan adı")
+	fs.StringVar(&o.NodePortRange, "nodeport-range", "30000-32767", "API server'da yapılandırılmış NodePort aralığı")
+	fs.Float64Var(&o.NodePortUsageThreshold, "nodeport-usage-threshold", 80, "NodePort aralığının yüzde kaçı dolduğunda uyarı verileceği")
+	fs.DurationVar(&o.EndpointStaleThreshold, "endpoint-stale-threshold", 5*time.Minute, "hazır olmayan ya da terminating endpoint'lerin uyarı verilmeden önce bekleyebileceği süre")
+	fs.StringVar(&o.ServiceProxyDaemonSets, "service-proxy-daemonsets", "kube-proxy,cilium,antrea-agent", "kube-system içinde Service trafiğini yöneten DaemonSet adayları; ilk bulunan kontrol edilir")
+	fs.DurationVar(&o.LoadBalancerPendingThreshold, "loadbalancer-pending-threshold", 5*time.Minute, "LoadBalancer Service'in adres almadan bekleyebileceği süre")
+	fs.BoolVar(&o.ConnectivityProbe, "connectivity-probe", false, "probe pod'ları başlatarak pod-pod, pod-service ve dış bağlantıyı test et")
+	fs.StringVar(&o.ProbeNamespace, "probe-namespace", "default", "probe pod'larının oluşturulacağı namespace")
+	fs.StringVar(&o.ProbeImage, "probe-image", "busybox:1.36", "probe pod'larında kullanılacak image")
+	fs.StringVar(&o.ProbeEgressURL, "probe-egress-url", "http://example.com/", "dış bağlantı testi için kullanılacak adres")
+	fs.IntVar(&o.ProbeCount, "probe-count", 5, "her yol için yapılacak deneme sayısı")
+	fs.DurationVar(&o.ProbeTimeout, "probe-timeout", 2*time.Minute, "probe pod'larının tamamlanması için beklenecek süre")
+	fs.DurationVar(&o.NodeNotReadyWarning, "node-notready-warning", 2*time.Minute, "NotReady node'un uyarı olarak raporlanmadan önce bekleyebileceği süre")
+	fs.DurationVar(&o.NodeNotReadyCritical, "node-notready-critical", 10*time.Minute, "NotReady node'un kritik olarak raporlanmadan önce bekleyebileceği süre")
+	fs.Float64Var(&o.NodeDiskThreshold, "node-disk-threshold", 80, "node dosya sistemlerinin (nodefs/imagefs) yüzde kaçı dolduğunda uyarı verileceği")
+	fs.Float64Var(&o.NodeInodeThreshold, "node-inode-threshold", 80, "node dosya sistemlerindeki inode'ların yüzde kaçı kullanıldığında uyarı verileceği")
+	fs.Float64Var(&o.NodeRequestThreshold, "node-request-threshold", 90, "node'un allocatable CPU/bellek kaynağının yüzde kaçı istendiğinde uyarı verileceği")
+	fs.DurationVar(&o.CordonStaleThreshold, "cordon-stale-threshold", 24*time.Hour, "cordon'lanmış node'un drain'i unutulmuş sayılmadan önce bekleyebileceği süre")
+	fs.IntVar(&o.KubeletMaxSkew, "kubelet-max-skew", 3, "kubelet'in API server'dan kaç minor sürüm geride olabileceği")
+	fs.DurationVar(&o.ClockSkewThreshold, "clock-skew-threshold", 5*time.Second, "node ya da API server saatinin bu aracın saatinden sapabileceği en fazla süre")
+	fs.DurationVar(&o.UnschedulablePodThreshold, "unschedulable-pod-threshold", 10*time.Minute, "schedule edilemeyen pod'un kapasite beklerken raporlanmadan önce bekleyebileceği süre")
+	fs.IntVar(&o.UnschedulableTrendCycles, "unschedulable-trend-cycles", 5, "schedule edilemeyen pod sayısının sürekli artış sayılması için gereken döngü sayısı")
+	fs.StringVar(&o.ExtendedResources, "extended-resources", "nvidia.com/gpu,amd.com/gpu,gpu.intel.com/i915", "device plugin'ler tarafından sunulması beklenen extended resource'lar")
+	fs.StringVar(&o.GPUNodeSelector, "gpu-node-selector", "", "extended resource sunması beklenen node'ları seçen label selector; boşsa bilinen GPU etiketleri kullanılır")
+	fs.Float64Var(&o.MemoryLimitThreshold, "memory-limit-threshold", 90, "container bellek kullanımının limitin yüzde kaçına ulaştığında uyarı verileceği")
+	fs.Float64Var(&o.CPULimitThreshold, "cpu-limit-threshold", 90, "container CPU kullanımının limitin yüzde kaçına ulaştığında throttling uyarısı verileceği")
+	fs.Int64Var(&o.PageSize, "page-size", 500, "List çağrılarında sayfa başına istenecek en fazla nesne sayısı; 0 sayfalamayı kapatır")
+	fs.IntVar(&o.RetryAttempts, "retry-attempts", 3, "geçici API hatalarında (zaman aşımı, 429, bağlantı kopması) bir isteğin en fazla kaç kez deneneceği")
+	fs.DurationVar(&o.RetryBackoff, "retry-backoff", 500*time.Millisecond, "geçici hatadan sonraki ilk yeniden deneme beklemesi; her denemede ikiye katlanır")
+	fs.IntVar(&o.EventBufferSize, "event-buffer-size", 5000, "event watch'larının her biri için bellekte tutulacak en fazla event sayısı")
+	fs.IntVar(&o.Shards, "shards", 1, "namespace'lerin paylaştırılacağı replica sayısı; namespace'lere göre bölünebilen kontrolleri her replica kendi namespace'leri için çalıştırır, cluster geneli kontrolleri yalnızca lider çalıştırıp sonuçları birleştirir")
+	fs.IntVar(&o.ShardIndex, "shard-index", 0, "bu replica'nın 0 ile --shards-1 arasındaki sırası; verilmezse StatefulSet pod adının sonundaki sıra numarası kullanılır")
+	fs.StringVar(&o.CheckTTLs, "check-ttls", "", "bulguları belirtilen süre boyunca önbellekten döndürülecek pahalı kontroller (ör. vulnerabilities=6h,cluster-admin-bindings=30m,wildcard-rules=30m)")
+}
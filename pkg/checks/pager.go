@@ -0,0 +1,83 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// listAll, list çağrısını Limit/Continue ile sayfa sayfa yapar ve tüm
+// sayfaların nesnelerini ilk sayfanın list nesnesinde birleştirir. Böylece
+// büyük cluster'larda tek bir dev yanıt istenmez. --page-size 0 ise sayfalama
+// yapılmaz. Her sayfa isteği geçici hatalarda retryTransient ile yeniden
+// denenir. --shards birden büyükken namespace'lere göre bölünebilir bir
+// kontrol içinde çağrılırsa yalnızca bu replica'nın namespace'lerindeki
+// nesneler döner (bkz. filtersShard).
+func listAll[L runtime.Object](ctx context.Context, opts Options, list func(context.Context, metav1.ListOptions) (L, error), options metav1.ListOptions) (L, error) {
+	var zero L
+	fetch := func() (L, error) {
+		var page L
+		err := retryTransient(ctx, opts, func() error {
+			var err error
+			page, err = list(ctx, options)
+			return err
+		})
+		return page, err
+	}
+	options.Limit = opts.PageSize
+	first, err := fetch()
+	if err != nil {
+		return zero, err
+	}
+	var items []runtime.Object
+	page := first
+	for {
+		listMeta, err := meta.ListAccessor(page)
+		if err != nil {
+			return zero, err
+		}
+		if listMeta.GetContinue() == "" {
+			break
+		}
+		if items == nil {
+			if items, err = meta.ExtractList(first); err != nil {
+				return zero, err
+			}
+		}
+		options.Continue = listMeta.GetContinue()
+		if page, err = fetch(); err != nil {
+			return zero, fmt.Errorf("%d nesneden sonraki sayfa alınamadı: %w", len(items), err)
+		}
+		pageItems, err := meta.ExtractList(page)
+		if err != nil {
+			return zero, err
+		}
+		items = append(items, pageItems...)
+	}
+	if filtersShard(ctx, opts) {
+		if items == nil {
+			if items, err = meta.ExtractList(first); err != nil {
+				return zero, err
+			}
+		}
+		items = shardObjects(opts, items)
+	}
+	if items == nil {
+		return first, nil
+	}
+	if err := meta.SetList(first, items); err != nil {
+		return zero, err
+	}
+	listMeta, _ := meta.ListAccessor(first)
+	listMeta.SetContinue("")
+	return first, nil
+}
+
+// ListAll, listAll'ı kontroller dışındaki paketlerin (ör. remediate) aynı
+// sayfalama, yeniden deneme ve sharding davranışıyla kullanabilmesini sağlar.
+func ListAll[L runtime.Object](ctx context.Context, opts Options, list func(context.Context, metav1.ListOptions) (L, error), options metav1.ListOptions) (L, error) {
+	return listAll(ctx, opts, list, options)
+}
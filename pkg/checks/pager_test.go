@@ -0,0 +1,147 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+// pagedPods, istenen Limit'e göre Continue token'ı döndüren bir list
+// fonksiyonudur; fake clientset sayfalamayı desteklemediğinden kullanılır.
+func pagedPods(pods []corev1.Pod, requests *[]metav1.ListOptions) func(context.Context, metav1.ListOptions) (*corev1.PodList, error) {
+	return func(_ context.Context, options metav1.ListOptions) (*corev1.PodList, error) {
+		*requests = append(*requests, options)
+		start := 0
+		if options.Continue != "" {
+			fmt.Sscanf(options.Continue, "%d", &start)
+		}
+		end := min(start+int(options.Limit), len(pods))
+		list := &corev1.PodList{Items: append([]corev1.Pod(nil), pods[start:end]...)}
+		if end < len(pods) {
+			list.Continue = fmt.Sprint(end)
+		}
+		return list, nil
+	}
+}
+
+func testPods(namespaces ...string) []corev1.Pod {
+	var pods []corev1.Pod
+	for i, namespace := range namespaces {
+		pods = append(pods, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: fmt.Sprintf("pod-%d", i)}})
+	}
+	return pods
+}
+
+func TestListAllFollowsContinue(t *testing.T) {
+	opts := DefaultOptions()
+	opts.PageSize = 2
+	var requests []metav1.ListOptions
+	pods, err := listAll(context.Background(), opts, pagedPods(testPods("a", "b", "c", "d", "e"), &requests), metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pods.Items) != 5 {
+		t.Errorf("5 pod beklenirken %d döndü", len(pods.Items))
+	}
+	if pods.Continue != "" {
+		t.Errorf("birleştirilen listede Continue boş olmalı, dönen %q", pods.Continue)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("3 sayfa isteği beklenirken %d yapıldı", len(requests))
+	}
+	for _, request := range requests {
+		if request.Limit != 2 || request.FieldSelector != "status.phase=Running" {
+			t.Errorf("her sayfa Limit ve FieldSelector ile istenmeli: %+v", request)
+		}
+	}
+}
+
+func TestListAllFiltersShardedContext(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Shards = 2
+	opts.ShardIndex = 1
+	namespaces := []string{"a", "b", "c", "d", "e", "f"}
+	clientset := fake.NewSimpleClientset()
+	for _, pod := range testPods(namespaces...) {
+		pod := pod
+		if _, err := clientset.CoreV1().Pods(pod.Namespace).Create(context.Background(), &pod, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := listAll(context.Background(), opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Items) != len(namespaces) {
+		t.Errorf("bölünmeyen bir kontrolde tüm pod'lar dönmeli; %d beklenirken %d döndü", len(namespaces), len(all.Items))
+	}
+
+	ctx := context.WithValue(context.Background(), shardedContextKey{}, true)
+	owned, err := listAll(ctx, opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := 0
+	for _, namespace := range namespaces {
+		if ownsNamespace(opts, namespace) {
+			want++
+		}
+	}
+	if len(owned.Items) != want {
+		t.Errorf("bu replica'nın %d pod'u dönmeli, %d döndü", want, len(owned.Items))
+	}
+	for _, pod := range owned.Items {
+		if !ownsNamespace(opts, pod.Namespace) {
+			t.Errorf("pod %s/%s başka bir replica'nın namespace'inde", pod.Namespace, pod.Name)
+		}
+	}
+}
+
+func TestCachedItemsShardsLikeListAll(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Shards = 2
+	opts.ShardIndex = 1
+	namespaces := []string{"a", "b", "c", "d", "e", "f"}
+	clientset := fake.NewSimpleClientset()
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, pod := range testPods(namespaces...) {
+		pod := pod
+		if _, err := clientset.CoreV1().Pods(pod.Namespace).Create(context.Background(), &pod, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+		if err := indexer.Add(&pod); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx := context.WithValue(context.Background(), shardedContextKey{}, true)
+	for _, namespace := range append([]string{metav1.NamespaceAll}, namespaces...) {
+		listed, err := listAll(ctx, opts, clientset.CoreV1().Pods(namespace).List, metav1.ListOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cached := cachedItems[corev1.Pod](ctx, opts, indexer, namespace)
+		names := func(pods []corev1.Pod) []string {
+			var names []string
+			for _, pod := range pods {
+				names = append(names, pod.Namespace+"/"+pod.Name)
+			}
+			sort.Strings(names)
+			return names
+		}
+		if got, want := names(cached), names(listed.Items); !reflect.DeepEqual(got, want) {
+			t.Errorf("namespace %q sorgusunda cache %v, listAll %v döndürdü", namespace, got, want)
+		}
+		if namespace != metav1.NamespaceAll && !ownsNamespace(opts, namespace) && len(cached) != 0 {
+			t.Errorf("başka bir replica'nın namespace'i %s için pod dönmemeli: %v", namespace, names(cached))
+		}
+	}
+}
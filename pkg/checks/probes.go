@@ -0,0 +1,205 @@
+package checks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+const probeName = "k8s-client-probe"
+
+// probeScript, her hedefe --probe-count kez istek atar ve başarılı istek
+// sayısını ve toplam gecikmeyi "PROBE <yol> <başarılı> <toplam> <ms>" olarak yazar.
+const probeScript = `probe() {
+  ok=0; total=0; i=0
+  while [ $i -lt $COUNT ]; do
+    i=$((i+1)); start=$(date +%s%N)
+    if wget -q -T 2 -O /dev/null "$2"; then
+      end=$(date +%s%N); ok=$((ok+1)); total=$((total+(end-start)/1000000))
+    fi
+  done
+  echo "PROBE $1 $ok $COUNT $total"
+}
+probe pod-pod "$POD_URL"
+probe pod-service "$SERVICE_URL"
+probe egress "$EGRESS_URL"`
+
+// checkConnectivity, --connectivity-probe verildiğinde bir sunucu pod'u ve
+// her hazır node'a bir istemci pod'u başlatarak pod-pod, pod-service ve dış
+// bağlantıyı test eder; yol başına paket kaybını ve gecikmeyi raporlar.
+// Oluşturulan tüm probe kaynakları kontrol sonunda silinir.
+func (s *Suite) checkConnectivity(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	if !s.opts.ConnectivityProbe {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.opts.ProbeTimeout)
+	defer cancel()
+	defer s.cleanupProbes(ctx, clientset, out)
+
+	labels := map[string]string{"app.kubernetes.io/name": probeName}
+	server := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: probeName + "-server", Labels: map[string]string{"app.kubernetes.io/name": probeName, "app.kubernetes.io/component": "server"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "server",
+				Image:   s.opts.ProbeImage,
+				Command: []string{"sh", "-c", "mkdir -p /www && echo ok > /www/index.html && httpd -f -p 8080 -h /www"},
+				Ports:   []corev1.ContainerPort{{ContainerPort: 8080}},
+			}},
+		},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: probeName, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Selector: server.Labels,
+			Ports:    []corev1.ServicePort{{Port: 8080, TargetPort: intstr.FromInt(8080)}},
+		},
+	}
+	if _, err := clientset.CoreV1().Pods(s.opts.ProbeNamespace).Create(ctx, server, metav1.CreateOptions{}); err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Probe sunucu pod'u oluşturulurken hata oluştu")
+	}
+	if _, err := clientset.CoreV1().Services(s.opts.ProbeNamespace).Create(ctx, service, metav1.CreateOptions{}); err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Probe Service'i oluşturulurken hata oluştu")
+	}
+	running, err := s.waitForPod(ctx, clientset, server.Name, func(pod *corev1.Pod) bool { return isPodReady(pod) })
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Probe sunucu pod'u hazır olmadı")
+	}
+
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Probe için node'ları", err)
+	}
+	var clients []string
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable || !isNodeReady(&node) {
+			continue
+		}
+		client := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: probeName + "-client-", Labels: labels},
+			Spec: corev1.PodSpec{
+				NodeName:      node.Name,
+				RestartPolicy: corev1.RestartPolicyNever,
+				Tolerations:   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+				Containers: []corev1.Container{{
+					Name:    "client",
+					Image:   s.opts.ProbeImage,
+					Command: []string{"sh", "-c", probeScript},
+					Env: []corev1.EnvVar{
+						{Name: "COUNT", Value: strconv.Itoa(s.opts.ProbeCount)},
+						{Name: "POD_URL", Value: fmt.Sprintf("http://%s:8080/", running.Status.PodIP)},
+						{Name: "SERVICE_URL", Value: fmt.Sprintf("http://%s.%s.svc:8080/", probeName, s.opts.ProbeNamespace)},
+						{Name: "EGRESS_URL", Value: s.opts.ProbeEgressURL},
+					},
+				}},
+			},
+		}
+		created, err := clientset.CoreV1().Pods(s.opts.ProbeNamespace).Create(ctx, client, metav1.CreateOptions{})
+		if err != nil {
+			out.infof("Node %s için probe istemci pod'u oluşturulurken hata oluştu: %v", node.Name, err)
+			continue
+		}
+		clients = append(clients, created.Name)
+	}
+
+	for _, name := range clients {
+		pod, err := s.waitForPod(ctx, clientset, name, func(pod *corev1.Pod) bool {
+			return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+		})
+		if err != nil {
+			out.infof("Probe pod'u %s tamamlanmadı: %v", name, err)
+			continue
+		}
+		logs, err := clientset.CoreV1().Pods(s.opts.ProbeNamespace).GetLogs(name, &corev1.PodLogOptions{}).DoRaw(ctx)
+		if err != nil {
+			out.infof("Probe pod'u %s logları alınırken hata oluştu: %v", name, err)
+			continue
+		}
+		reportProbeResults(out, pod.Spec.NodeName, logs)
+	}
+	return nil
+}
+
+// reportProbeResults, bir istemci pod'unun "PROBE" satırlarını okuyarak yol
+// başına paket kaybını ve ortalama gecikmeyi yazar.
+func reportProbeResults(out *findings, nodeName string, logs []byte) {
+	var failed []string
+	scanner := bufio.NewScanner(bytes.NewReader(logs))
+	for scanner.Scan() {
+		var path string
+		var ok, total int
+		var elapsedMs int64
+		if _, err := fmt.Sscanf(scanner.Text(), "PROBE %s %d %d %d", &path, &ok, &total, &elapsedMs); err != nil || total == 0 {
+			continue
+		}
+		loss := float64(total-ok) / float64(total) * 100
+		if ok == 0 {
+			failed = append(failed, fmt.Sprintf("%s (%d deneme)", path, total))
+			continue
+		}
+		severity := report.Info
+		if loss > 0 {
+			severity = report.Warning
+		}
+		out.addf(severity, "Node %s üzerinden %s: %%%.0f kayıp, ortalama %dms", nodeName, path, loss, elapsedMs/int64(ok))
+	}
+	if len(failed) > 0 {
+		out.objectf(report.Critical, nodeRef(nodeName), "ProbeConnectionFailed", "Node %s üzerinden şu bağlantılar tamamen başarısız: %s", nodeName, strings.Join(failed, ", "))
+	}
+}
+
+// waitForPod, probe namespace'indeki pod verilen koşulu sağlayana kadar bekler.
+func (s *Suite) waitForPod(ctx context.Context, clientset kubernetes.Interface, name string, done func(*corev1.Pod) bool) (*corev1.Pod, error) {
+	for {
+		pod, err := clientset.CoreV1().Pods(s.opts.ProbeNamespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if done(pod) {
+			return pod, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(2 * time.Second):
+		}
+	}
+}
+
+// cleanupProbes, checkConnectivity tarafından oluşturulan pod ve Service'i siler.
+// Kontrol zaman aşımına uğrasa ya da iptal edilse de probe'lar cluster'da
+// kalmasın diye silme işlemi ctx'in iptalinden bağımsız, kısa bir süreyle yapılır.
+func (s *Suite) cleanupProbes(ctx context.Context, clientset kubernetes.Interface, out *findings) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+	selector := metav1.ListOptions{LabelSelector: "app.kubernetes.io/name=" + probeName}
+	if err := clientset.CoreV1().Pods(s.opts.ProbeNamespace).DeleteCollection(ctx, metav1.DeleteOptions{}, selector); err != nil {
+		out.infof("Probe pod'ları silinirken hata oluştu: %v", err)
+	}
+	err := clientset.CoreV1().Services(s.opts.ProbeNamespace).Delete(ctx, probeName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		out.infof("Probe Service'i silinirken hata oluştu: %v", err)
+	}
+}
+
+// isNodeReady, node'un Ready koşulunun True olup olmadığını döndürür.
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
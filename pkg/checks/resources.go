@@ -0,0 +1,148 @@
+package checks
+
+import (
+	"context"
+	"os"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// ResourceCheckConfig, yapılandırma dosyasında group/version/resource ile
+// tanımlanan ve dynamic istemciyle çalışan genel bir sayı/koşul kontrolüdür.
+// Böylece kullanıcılar kendi CRD'lerini Go kodu yazmadan izleyebilir.
+type ResourceCheckConfig struct {
+	Name          string `json:"name"`
+	Group         string `json:"group,omitempty"`
+	Version       string `json:"version"`
+	Resource      string `json:"resource"`
+	Namespace     string `json:"namespace,omitempty"`
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// MinCount ve MaxCount, nesne sayısı için beklenen aralıktır; 0 sınır yok demektir.
+	MinCount int `json:"minCount,omitempty"`
+	MaxCount int `json:"maxCount,omitempty"`
+	// Condition verilmişse her nesnenin status.conditions listesinde bu
+	// tipteki koşulun beklenen durumda olması gerekir.
+	Condition *ResourceCondition `json:"condition,omitempty"`
+	// Severity, beklentiye uymayan durumlar için bulgunun önem derecesidir;
+	// verilmezse warning kullanılır.
+	Severity *report.Severity `json:"severity,omitempty"`
+}
+
+// ResourceCondition, nesnelerde beklenen koşul tipi ve durumudur.
+type ResourceCondition struct {
+	Type   string `json:"type"`
+	Status string `json:"status,omitempty"`
+}
+
+// ResourceChecksFile, genel resource kontrollerini listeleyen YAML ya da JSON dosyasıdır:
+//
+//	resourceChecks:
+//	  - name: kafka-topics
+//	    group: kafka.strimzi.io
+//	    version: v1beta2
+//	    resource: kafkatopics
+//	    condition: {type: Ready, status: "True"}
+//	  - name: cluster-issuers
+//	    group: cert-manager.io
+//	    version: v1
+//	    resource: clusterissuers
+//	    minCount: 1
+//	    severity: critical
+type ResourceChecksFile struct {
+	ResourceChecks []ResourceCheckConfig `json:"resourceChecks"`
+}
+
+// LoadResourceChecks, path'teki yapılandırma dosyasından genel resource
+// kontrollerini "resource/<name>" adlarıyla oluşturur.
+func (s *Suite) LoadResourceChecks(path string) ([]Check, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file ResourceChecksFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, checkerrors.Wrap(checkerrors.CodeInvalidConfig, err, "%s çözülemedi", path)
+	}
+	var list []Check
+	for i, config := range file.ResourceChecks {
+		if config.Name == "" || config.Version == "" || config.Resource == "" {
+			return nil, checkerrors.New(checkerrors.CodeInvalidConfig, "%s içindeki %d. resource kontrolünde name, version ve resource zorunludur", path, i+1)
+		}
+		if config.Condition != nil && config.Condition.Type == "" {
+			return nil, checkerrors.New(checkerrors.CodeInvalidConfig, "resource kontrolü %s için condition.type zorunludur", config.Name)
+		}
+		config := config
+		var check Check = checkFunc{"resource/" + config.Name, func(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+			return s.checkResource(ctx, clientset, config, out)
+		}}
+		if config.Group != "" {
+			check = requireAPIGroups(check, config.Group)
+		}
+		list = append(list, check)
+	}
+	return list, nil
+}
+
+// checkResource, yapılandırılan resource'un nesnelerini sayar ve beklenen
+// koşulu sağlamayanları raporlar. Resource API server'da sunulmuyorsa (CRD
+// kurulu değilse) kontrol atlanır.
+func (s *Suite) checkResource(ctx context.Context, clientset kubernetes.Interface, config ResourceCheckConfig, out *findings) error {
+	severity := report.Warning
+	if config.Severity != nil {
+		severity = *config.Severity
+	}
+	gvr, served, err := servedResource(ctx, clientset, config.Group, config.Resource, config.Version)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "%s resource'u aranırken hata oluştu", config.Resource)
+	}
+	if !served {
+		out.infof("%s resource'u API server'da sunulmuyor, kontrol atlandı", gvr.GroupResource())
+		return nil
+	}
+	list, err := listAll(ctx, s.opts, s.dynamic.Resource(gvr).Namespace(config.Namespace).List, metav1.ListOptions{LabelSelector: config.LabelSelector})
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeListFailed, err, "%s nesnelerini listelerken hata oluştu", gvr.GroupResource())
+	}
+
+	count := len(list.Items)
+	out.infof("Cluster'da %d %s var", count, gvr.GroupResource())
+	if config.MinCount > 0 && count < config.MinCount {
+		out.addf(severity, "%s sayısı %d, beklenen en az %d", gvr.GroupResource(), count, config.MinCount)
+	}
+	if config.MaxCount > 0 && count > config.MaxCount {
+		out.addf(severity, "%s sayısı %d, beklenen en fazla %d", gvr.GroupResource(), count, config.MaxCount)
+	}
+
+	if config.Condition == nil {
+		return nil
+	}
+	expected := config.Condition.Status
+	if expected == "" {
+		expected = "True"
+	}
+	for _, item := range list.Items {
+		name := item.GetName()
+		if item.GetNamespace() != "" {
+			name = item.GetNamespace() + "/" + name
+		}
+		var found *condition
+		for _, c := range unstructuredConditions(item.Object, "status", "conditions") {
+			if c.Type == config.Condition.Type {
+				c := c
+				found = &c
+				break
+			}
+		}
+		switch {
+		case found == nil:
+			out.addf(severity, "%s %s nesnesinde %s koşulu yok", item.GetKind(), name, config.Condition.Type)
+		case found.Status != expected:
+			out.addf(severity, "%s %s %s koşulu %s (beklenen %s): %s %s", item.GetKind(), name, found.Type, found.Status, expected, found.Reason, found.Message)
+		}
+	}
+	return nil
+}
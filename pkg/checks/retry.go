@@ -0,0 +1,54 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// retryTransient, fn'i geçici API hatalarında --retry-attempts denemeye kadar
+// tekrarlar. Denemeler arasında --retry-backoff'tan başlayıp her seferinde
+// ikiye katlanan, eşzamanlı kontrollerin aynı anda yeniden denememesi için
+// jitter eklenmiş bir süre beklenir; hata Retry-After öneriyorsa en az o
+// kadar beklenir. Böylece kısa bir API kesintisi bir dizi
+// yanlış alarma dönüşmez. Kalıcı hatalar ve ctx iptali hemen döner.
+func retryTransient(ctx context.Context, opts Options, fn func() error) error {
+	delay := opts.RetryBackoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= opts.RetryAttempts || !isTransient(err) {
+			return err
+		}
+		backoff := wait.Jitter(delay, 1)
+		// API server'ın önerdiği Retry-After süresinden önce yeniden denenmez.
+		if seconds, ok := apierrors.SuggestsClientDelay(err); ok && time.Duration(seconds)*time.Second > backoff {
+			backoff = time.Duration(seconds) * time.Second
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		delay *= 2
+	}
+}
+
+// isTransient, hatanın API server'ın geçici olarak yanıt verememesinden ya da
+// bağlantı kopmasından kaynaklanıp kaynaklanmadığını döndürür.
+func isTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		utilnet.IsConnectionReset(err) ||
+		utilnet.IsConnectionRefused(err) ||
+		utilnet.IsProbableEOF(err) ||
+		utilnet.IsTimeout(err)
+}
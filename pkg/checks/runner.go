@@ -0,0 +1,69 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Result, bir kontrolün tek bir çalışmasının bulgularını ve hatasını tutar.
+type Result struct {
+	Check    Check
+	Findings []report.Finding
+	Err      error
+}
+
+// RunAll, kontrolleri aynı anda en fazla concurrency tanesi çalışacak şekilde
+// paralel çalıştırır; böylece yavaş bir kontrol tüm döngüyü bekletmez ve API
+// server'a giden eşzamanlı istek sayısı sınırlı kalır. Sonuçlar, çıktının
+// döngüden döngüye aynı kalması için kontrollerin verildiği sırayla döner.
+// concurrency 1'den küçükse kontroller sırayla çalıştırılır.
+//
+// Her kontrol ctx'ten türetilen ve timeout sonra sona eren kendi context'iyle
+// çalışır (timeout 0 ise süre sınırı yoktur). ctx iptal edildiğinde çalışan
+// kontrollerin API istekleri hemen iptal olur, henüz başlamamış kontroller
+// ise çalıştırılmadan ctx hatasıyla döner.
+func RunAll(ctx context.Context, clientset kubernetes.Interface, checks []Check, concurrency int, timeout time.Duration) []Result {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]Result, len(checks))
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		select {
+		case <-ctx.Done():
+			results[i] = Result{Check: check, Err: checkerrors.Wrap(checkerrors.CodeCanceled, ctx.Err(), "%s kontrolü başlatılmadı", check.Name())}
+			continue
+		case slots <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			results[i] = runWithTimeout(ctx, clientset, check, timeout)
+		}(i, check)
+	}
+	wg.Wait()
+	return results
+}
+
+// runWithTimeout, kontrolü kendi süre sınırıyla çalıştırır ve süre sınırına
+// takılan hataları kontrol adıyla birlikte açıklar.
+func runWithTimeout(ctx context.Context, clientset kubernetes.Interface, check Check, timeout time.Duration) Result {
+	checkCtx, cancel := ctx, context.CancelFunc(func() {})
+	if timeout > 0 {
+		checkCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+	findings, err := check.Run(checkCtx, clientset)
+	if err != nil && ctx.Err() == nil && errors.Is(checkCtx.Err(), context.DeadlineExceeded) {
+		err = checkerrors.Wrap(checkerrors.CodeTimeout, err, "%s kontrolü %s içinde tamamlanmadı", check.Name(), timeout)
+	}
+	return Result{Check: check, Findings: findings, Err: err}
+}
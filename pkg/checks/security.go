@@ -0,0 +1,1284 @@
+package checks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// checkClusterAdminBindings, cluster-admin ya da ona eşdeğer (tüm API
+// gruplarında tüm kaynaklara tüm fiiller) ClusterRole'leri kullanıcılara,
+// gruplara ve ServiceAccount'lara bağlayan ClusterRoleBinding'leri listeler;
+// izin listesinde olmayan subject'leri işaretler.
+func (s *Suite) checkClusterAdminBindings(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	roles, err := s.listClusterRoles(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("ClusterRole'leri", err)
+	}
+	bindings, err := s.listClusterRoleBindings(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("ClusterRoleBinding'leri", err)
+	}
+	adminRoles := map[string]bool{"cluster-admin": true}
+	for _, role := range roles.Items {
+		for _, rule := range role.Rules {
+			if isClusterAdminRule(rule) {
+				adminRoles[role.Name] = true
+			}
+		}
+	}
+	allowed := map[string]bool{}
+	for _, subject := range splitList(s.opts.ClusterAdminAllowedSubjects) {
+		allowed[subject] = true
+	}
+
+	for _, binding := range bindings.Items {
+		if binding.RoleRef.Kind != "ClusterRole" || !adminRoles[binding.RoleRef.Name] {
+			continue
+		}
+		var subjects []string
+		for _, subject := range binding.Subjects {
+			if name := subjectString(subject); !allowed[name] {
+				subjects = append(subjects, name)
+			}
+		}
+		if len(subjects) > 0 {
+			out.objectf(report.Info, report.ResourceRef{Kind: "ClusterRoleBinding", Name: binding.Name}, "ClusterAdminBinding", "ClusterRoleBinding %s, %s subject'ine %s yetkisi veriyor", binding.Name, strings.Join(subjects, ", "), binding.RoleRef.Name)
+		}
+	}
+	return nil
+}
+
+// isClusterAdminRule, kuralın tüm API gruplarındaki tüm kaynaklara tüm
+// fiilleri verip vermediğini döndürür.
+func isClusterAdminRule(rule rbacv1.PolicyRule) bool {
+	return containsWildcard(rule.APIGroups) && containsWildcard(rule.Resources) && containsWildcard(rule.Verbs)
+}
+
+func containsWildcard(values []string) bool {
+	for _, value := range values {
+		if value == rbacv1.ResourceAll {
+			return true
+		}
+	}
+	return false
+}
+
+// subjectString, RBAC subject'ini "Tür:ad" biçiminde döndürür. ServiceAccount'lar
+// için ad "namespace/ad" biçimindedir.
+func subjectString(subject rbacv1.Subject) string {
+	if subject.Kind == rbacv1.ServiceAccountKind {
+		return subject.Kind + ":" + subject.Namespace + "/" + subject.Name
+	}
+	return subject.Kind + ":" + subject.Name
+}
+
+// checkWildcardRules, fiil, kaynak ya da API grubu olarak "*" içeren kurallara
+// sahip Role ve ClusterRole'leri bulur ve bu rollere bağlı subject'leri
+// raporlar. Aşırı yetkili operator'ları yakalamak için kullanılır.
+func (s *Suite) checkWildcardRules(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	clusterRoles, err := s.listClusterRoles(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("ClusterRole'leri", err)
+	}
+	roles, err := s.listRoles(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Role'leri", err)
+	}
+	clusterRoleBindings, err := s.listClusterRoleBindings(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("ClusterRoleBinding'leri", err)
+	}
+	roleBindings, err := s.listRoleBindings(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("RoleBinding'leri", err)
+	}
+
+	// wildcard, "ClusterRole ad" ya da "Role namespace/ad" anahtarını ilk
+	// wildcard kurala, refs ise rolün kendisine eşler.
+	wildcard := map[string]rbacv1.PolicyRule{}
+	refs := map[string]report.ResourceRef{}
+	for _, role := range clusterRoles.Items {
+		if s.opts.RBACSkipSystemRoles && strings.HasPrefix(role.Name, "system:") {
+			continue
+		}
+		if rule, ok := firstWildcardRule(role.Rules); ok {
+			wildcard["ClusterRole "+role.Name] = rule
+			refs["ClusterRole "+role.Name] = report.ResourceRef{Kind: "ClusterRole", Name: role.Name}
+		}
+	}
+	for _, role := range roles.Items {
+		if s.opts.RBACSkipSystemRoles && strings.HasPrefix(role.Name, "system:") {
+			continue
+		}
+		if rule, ok := firstWildcardRule(role.Rules); ok {
+			wildcard["Role "+role.Namespace+"/"+role.Name] = rule
+			refs["Role "+role.Namespace+"/"+role.Name] = report.ResourceRef{Kind: "Role", Namespace: role.Namespace, Name: role.Name}
+		}
+	}
+
+	bound := map[string][]string{}
+	for _, binding := range clusterRoleBindings.Items {
+		key := "ClusterRole " + binding.RoleRef.Name
+		for _, subject := range binding.Subjects {
+			bound[key] = append(bound[key], subjectString(subject)+" (ClusterRoleBinding "+binding.Name+")")
+		}
+	}
+	for _, binding := range roleBindings.Items {
+		key := "ClusterRole " + binding.RoleRef.Name
+		if binding.RoleRef.Kind == "Role" {
+			key = "Role " + binding.Namespace + "/" + binding.RoleRef.Name
+		}
+		for _, subject := range binding.Subjects {
+			bound[key] = append(bound[key], subjectString(subject)+" (RoleBinding "+binding.Namespace+"/"+binding.Name+")")
+		}
+	}
+
+	keys := make([]string, 0, len(wildcard))
+	for key := range wildcard {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rule := wildcard[key]
+		out.objectf(report.Info, refs[key], "WildcardRule", "%s wildcard kural içeriyor (apiGroups: %v, resources: %v, verbs: %v)", key, rule.APIGroups, rule.Resources, rule.Verbs)
+		for _, subject := range bound[key] {
+			out.infof("  Bağlı subject: %s", subject)
+		}
+	}
+	return nil
+}
+
+// firstWildcardRule, fiil, kaynak ya da API grubu olarak "*" içeren ilk kuralı döndürür.
+func firstWildcardRule(rules []rbacv1.PolicyRule) (rbacv1.PolicyRule, bool) {
+	for _, rule := range rules {
+		if containsWildcard(rule.APIGroups) || containsWildcard(rule.Resources) || containsWildcard(rule.Verbs) {
+			return rule, true
+		}
+	}
+	return rbacv1.PolicyRule{}, false
+}
+
+// checkServiceAccountAutomount, hiçbir RBAC binding'inde geçmediği için API
+// erişimine ihtiyaç duymadığı varsayılan ServiceAccount'ları kullanıp token'ı
+// otomatik bağlayan pod'ları namespace bazında raporlar. Token'ı otomatik
+// bağlanan "default" ServiceAccount'lar da sıkılaştırma önerisi olarak listelenir.
+func (s *Suite) checkServiceAccountAutomount(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	serviceAccounts, err := s.listServiceAccounts(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("ServiceAccount'ları", err)
+	}
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Automount kontrolü için pod'ları", err)
+	}
+	bound, err := s.boundServiceAccounts(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("RBAC binding'lerini", err)
+	}
+
+	automount := map[string]bool{}
+	for _, sa := range serviceAccounts.Items {
+		key := sa.Namespace + "/" + sa.Name
+		automount[key] = sa.AutomountServiceAccountToken == nil || *sa.AutomountServiceAccountToken
+		if sa.Name == "default" && automount[key] && !bound[key] {
+			out.objectf(report.Info, report.ResourceRef{Kind: "ServiceAccount", Namespace: sa.Namespace, Name: sa.Name}, "AutomountToken", "Namespace %s içindeki default ServiceAccount token'ı otomatik bağlıyor", sa.Namespace)
+		}
+	}
+
+	perNamespace := map[string]int{}
+	for _, pod := range pods.Items {
+		serviceAccount := pod.Spec.ServiceAccountName
+		if serviceAccount == "" {
+			serviceAccount = "default"
+		}
+		key := pod.Namespace + "/" + serviceAccount
+		mounted := automount[key]
+		if pod.Spec.AutomountServiceAccountToken != nil {
+			mounted = *pod.Spec.AutomountServiceAccountToken
+		}
+		if mounted && !bound[key] {
+			perNamespace[pod.Namespace]++
+		}
+	}
+	namespaces := make([]string, 0, len(perNamespace))
+	for namespace := range perNamespace {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		out.objectf(report.Info, namespaceRef(namespace), "UnneededTokenMount", "Namespace %s içinde %d pod API yetkisi olmayan bir ServiceAccount'un token'ını gereksiz yere bağlıyor", namespace, perNamespace[namespace])
+	}
+	return nil
+}
+
+// boundServiceAccounts, herhangi bir RoleBinding ya da ClusterRoleBinding'de
+// subject olarak geçen ServiceAccount'ları namespace/ad anahtarıyla döndürür.
+func (s *Suite) boundServiceAccounts(ctx context.Context, clientset kubernetes.Interface) (map[string]bool, error) {
+	clusterRoleBindings, err := s.listClusterRoleBindings(ctx, clientset)
+	if err != nil {
+		return nil, err
+	}
+	roleBindings, err := s.listRoleBindings(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	bound := map[string]bool{}
+	mark := func(namespace string, subjects []rbacv1.Subject) {
+		for _, subject := range subjects {
+			if subject.Kind != rbacv1.ServiceAccountKind {
+				continue
+			}
+			// RoleBinding'lerde namespace'i boş bırakılan subject'ler binding'in namespace'ine aittir.
+			subjectNamespace := subject.Namespace
+			if subjectNamespace == "" {
+				subjectNamespace = namespace
+			}
+			bound[subjectNamespace+"/"+subject.Name] = true
+		}
+	}
+	for _, binding := range clusterRoleBindings.Items {
+		mark("", binding.Subjects)
+	}
+	for _, binding := range roleBindings.Items {
+		mark(binding.Namespace, binding.Subjects)
+	}
+	return bound, nil
+}
+
+// checkMissingSecrets, pod'larda ve iş yükü şablonlarında var olmayan
+// Secret'lara yapılan referansları raporlar. Bu hatalar aksi halde ancak pod
+// bir sonraki başlatılışında ortaya çıkar.
+func (s *Suite) checkMissingSecrets(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Secret referansları için iş yüklerini", err)
+	}
+	secrets, err := listAll(ctx, s.opts, clientset.CoreV1().Secrets("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("Secret'ları", err)
+	}
+	existing := map[string]bool{}
+	for _, secret := range secrets.Items {
+		existing[secret.Namespace+"/"+secret.Name] = true
+	}
+	for _, source := range sources {
+		var missing []string
+		for _, ref := range podSpecSecretReferences(source.spec) {
+			if !ref.optional && !existing[source.namespace+"/"+ref.name] {
+				missing = append(missing, fmt.Sprintf("%s (%s)", ref.name, ref.usage))
+			}
+		}
+		if len(missing) > 0 {
+			out.objectf(report.Info, source.ref(), "MissingSecret", "%s var olmayan Secret'lere referans içeriyor: %s", source, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// checkMissingConfigMaps, pod'larda ve iş yükü şablonlarında var olmayan
+// ConfigMap'lere yapılan env, volume ve projected volume referanslarını,
+// bir sonraki yeniden başlatma pod'u bozmadan önce raporlar.
+func (s *Suite) checkMissingConfigMaps(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("ConfigMap referansları için iş yüklerini", err)
+	}
+	configMaps, err := listAll(ctx, s.opts, clientset.CoreV1().ConfigMaps("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("ConfigMap'leri", err)
+	}
+	existing := map[string]bool{}
+	for _, configMap := range configMaps.Items {
+		existing[configMap.Namespace+"/"+configMap.Name] = true
+	}
+	for _, source := range sources {
+		var missing []string
+		for _, ref := range podSpecConfigMapReferences(source.spec) {
+			if !ref.optional && !existing[source.namespace+"/"+ref.name] {
+				missing = append(missing, fmt.Sprintf("%s (%s)", ref.name, ref.usage))
+			}
+		}
+		if len(missing) > 0 {
+			out.objectf(report.Info, source.ref(), "MissingConfigMap", "%s var olmayan ConfigMap'lere referans içeriyor: %s", source, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// unusedConfigIgnoredSecretTypes, başka bileşenler tarafından doğrudan okunduğu
+// için pod referansı olmadan da kullanımda olan Secret tipleridir.
+var unusedConfigIgnoredSecretTypes = map[corev1.SecretType]bool{
+	corev1.SecretTypeServiceAccountToken: true,
+	corev1.SecretTypeBootstrapToken:      true,
+	"helm.sh/release.v1":                 true,
+}
+
+// checkUnusedConfig, hiçbir pod, iş yükü, Ingress ya da ServiceAccount
+// tarafından referans verilmeyen ve --unused-config-min-age süresinden eski
+// ConfigMap ve Secret'ları yaşlarıyla birlikte raporlar.
+func (s *Suite) checkUnusedConfig(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Kullanılmayan yapılandırma kontrolü için iş yüklerini", err)
+	}
+	ingresses, err := s.listIngresses(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Ingress'leri", err)
+	}
+	serviceAccounts, err := s.listServiceAccounts(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("ServiceAccount'ları", err)
+	}
+	configMaps, err := listAll(ctx, s.opts, clientset.CoreV1().ConfigMaps("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("ConfigMap'leri", err)
+	}
+	secrets, err := listAll(ctx, s.opts, clientset.CoreV1().Secrets("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("Secret'ları", err)
+	}
+
+	usedSecrets := map[string]bool{}
+	usedConfigMaps := map[string]bool{}
+	for _, source := range sources {
+		for _, ref := range podSpecSecretReferences(source.spec) {
+			usedSecrets[source.namespace+"/"+ref.name] = true
+		}
+		for _, ref := range podSpecConfigMapReferences(source.spec) {
+			usedConfigMaps[source.namespace+"/"+ref.name] = true
+		}
+	}
+	for _, ingress := range ingresses.Items {
+		for _, tls := range ingress.Spec.TLS {
+			usedSecrets[ingress.Namespace+"/"+tls.SecretName] = true
+		}
+	}
+	for _, sa := range serviceAccounts.Items {
+		for _, secret := range sa.Secrets {
+			usedSecrets[sa.Namespace+"/"+secret.Name] = true
+		}
+		for _, secret := range sa.ImagePullSecrets {
+			usedSecrets[sa.Namespace+"/"+secret.Name] = true
+		}
+	}
+	excluded := map[string]bool{}
+	for _, namespace := range splitList(s.opts.UnusedConfigExcludeNamespaces) {
+		excluded[namespace] = true
+	}
+
+	for _, configMap := range configMaps.Items {
+		age := time.Since(configMap.CreationTimestamp.Time)
+		if excluded[configMap.Namespace] || configMap.Name == "kube-root-ca.crt" || age < s.opts.UnusedConfigMinAge {
+			continue
+		}
+		if !usedConfigMaps[configMap.Namespace+"/"+configMap.Name] {
+			out.objectf(report.Info, report.ResourceRef{Kind: "ConfigMap", Namespace: configMap.Namespace, Name: configMap.Name}, "Unused", "ConfigMap %s namespace %s hiçbir yerde kullanılmıyor (yaş: %s)", configMap.Name, configMap.Namespace, age.Round(time.Hour))
+		}
+	}
+	for _, secret := range secrets.Items {
+		age := time.Since(secret.CreationTimestamp.Time)
+		if excluded[secret.Namespace] || unusedConfigIgnoredSecretTypes[secret.Type] || age < s.opts.UnusedConfigMinAge {
+			continue
+		}
+		if !usedSecrets[secret.Namespace+"/"+secret.Name] {
+			out.objectf(report.Info, report.ResourceRef{Kind: "Secret", Namespace: secret.Namespace, Name: secret.Name}, "Unused", "Secret %s namespace %s hiçbir yerde kullanılmıyor (yaş: %s)", secret.Name, secret.Namespace, age.Round(time.Hour))
+		}
+	}
+	return nil
+}
+
+var (
+	pssBaselineCapabilities = map[corev1.Capability]bool{
+		"AUDIT_WRITE": true, "CHOWN": true, "DAC_OVERRIDE": true, "FOWNER": true, "FSETID": true, "KILL": true, "MKNOD": true,
+		"NET_BIND_SERVICE": true, "SETFCAP": true, "SETGID": true, "SETPCAP": true, "SETUID": true, "SYS_CHROOT": true,
+	}
+	pssSafeSysctls = map[string]bool{
+		"kernel.shm_rmid_forced": true, "net.ipv4.ip_local_port_range": true, "net.ipv4.ip_unprivileged_port_start": true,
+		"net.ipv4.tcp_syncookies": true, "net.ipv4.ping_group_range": true,
+	}
+)
+
+// checkPodSecurityStandards, pod'ları Pod Security Standards baseline ve
+// restricted profillerine göre değerlendirir ve ihlalleri namespace'in
+// pod-security.kubernetes.io etiketleriyle birlikte namespace bazında raporlar.
+// Restricted ihlallerinin ayrıntısı yalnızca restricted seviyesini hedefleyen
+// namespace'ler için yazılır.
+func (s *Suite) checkPodSecurityStandards(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	namespaces, err := s.listNamespaces(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("PSS kontrolü için namespace'leri", err)
+	}
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("PSS kontrolü için pod'ları", err)
+	}
+	podsByNamespace := map[string][]corev1.Pod{}
+	for _, pod := range pods.Items {
+		podsByNamespace[pod.Namespace] = append(podsByNamespace[pod.Namespace], pod)
+	}
+
+	for _, namespace := range namespaces.Items {
+		enforce := namespace.Labels["pod-security.kubernetes.io/enforce"]
+		warn := namespace.Labels["pod-security.kubernetes.io/warn"]
+		audit := namespace.Labels["pod-security.kubernetes.io/audit"]
+		targetsRestricted := enforce == "restricted" || warn == "restricted" || audit == "restricted"
+
+		baselineCount, restrictedCount := 0, 0
+		for _, pod := range podsByNamespace[namespace.Name] {
+			baseline := pssBaselineViolations(&pod.Spec)
+			restricted := pssRestrictedViolations(&pod.Spec)
+			if len(baseline) > 0 {
+				baselineCount++
+				out.objectf(report.Info, podRef(&pod), "PSSBaselineViolation", "Pod %s namespace %s baseline ihlalleri: %s", pod.Name, pod.Namespace, strings.Join(baseline, "; "))
+			}
+			if len(baseline)+len(restricted) > 0 {
+				restrictedCount++
+				if targetsRestricted && len(restricted) > 0 {
+					out.objectf(report.Info, podRef(&pod), "PSSRestrictedViolation", "Pod %s namespace %s restricted ihlalleri: %s", pod.Name, pod.Namespace, strings.Join(restricted, "; "))
+				}
+			}
+		}
+		if baselineCount+restrictedCount == 0 {
+			continue
+		}
+		out.objectf(report.Info, namespaceRef(namespace.Name), "PSSViolations", "Namespace %s (enforce=%q, warn=%q, audit=%q): %d pod baseline, %d pod restricted profilini ihlal ediyor", namespace.Name, enforce, warn, audit, baselineCount, restrictedCount)
+	}
+	return nil
+}
+
+// pssBaselineViolations, pod tanımının PSS baseline profilini ihlal eden yönlerini döndürür.
+func pssBaselineViolations(spec *corev1.PodSpec) []string {
+	var violations []string
+	if spec.HostNetwork || spec.HostPID || spec.HostIPC {
+		violations = append(violations, "host namespace'leri kullanılıyor")
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			violations = append(violations, "hostPath volume "+volume.Name)
+		}
+	}
+	if spec.SecurityContext != nil {
+		if profile := spec.SecurityContext.SeccompProfile; profile != nil && profile.Type == corev1.SeccompProfileTypeUnconfined {
+			violations = append(violations, "pod seccomp profili Unconfined")
+		}
+		for _, sysctl := range spec.SecurityContext.Sysctls {
+			if !pssSafeSysctls[sysctl.Name] {
+				violations = append(violations, "güvenli olmayan sysctl "+sysctl.Name)
+			}
+		}
+	}
+	for _, container := range allContainers(spec) {
+		for _, port := range container.Ports {
+			if port.HostPort != 0 {
+				violations = append(violations, fmt.Sprintf("container %s hostPort %d", container.Name, port.HostPort))
+			}
+		}
+		sc := container.SecurityContext
+		if sc == nil {
+			continue
+		}
+		if sc.Privileged != nil && *sc.Privileged {
+			violations = append(violations, "container "+container.Name+" privileged")
+		}
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if !pssBaselineCapabilities[capability] {
+					violations = append(violations, fmt.Sprintf("container %s %s yetkisi ekliyor", container.Name, capability))
+				}
+			}
+		}
+		if sc.ProcMount != nil && *sc.ProcMount == corev1.UnmaskedProcMount {
+			violations = append(violations, "container "+container.Name+" Unmasked procMount")
+		}
+		if sc.SeccompProfile != nil && sc.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined {
+			violations = append(violations, "container "+container.Name+" seccomp profili Unconfined")
+		}
+	}
+	return violations
+}
+
+// pssRestrictedViolations, pod tanımının baseline'a ek olarak restricted
+// profilinin getirdiği kuralları ihlal eden yönlerini döndürür.
+func pssRestrictedViolations(spec *corev1.PodSpec) []string {
+	var violations []string
+	for _, volume := range spec.Volumes {
+		source := volume.VolumeSource
+		if source.ConfigMap == nil && source.CSI == nil && source.DownwardAPI == nil && source.EmptyDir == nil &&
+			source.Ephemeral == nil && source.PersistentVolumeClaim == nil && source.Projected == nil && source.Secret == nil {
+			violations = append(violations, "izin verilmeyen volume tipi "+volume.Name)
+		}
+	}
+	podNonRoot, podSeccomp := false, false
+	if spec.SecurityContext != nil {
+		podNonRoot = spec.SecurityContext.RunAsNonRoot != nil && *spec.SecurityContext.RunAsNonRoot
+		if profile := spec.SecurityContext.SeccompProfile; profile != nil && profile.Type != corev1.SeccompProfileTypeUnconfined {
+			podSeccomp = true
+		}
+		if spec.SecurityContext.RunAsUser != nil && *spec.SecurityContext.RunAsUser == 0 {
+			violations = append(violations, "pod root kullanıcısıyla çalışıyor")
+		}
+	}
+	for _, container := range allContainers(spec) {
+		sc := container.SecurityContext
+		if sc == nil {
+			sc = &corev1.SecurityContext{}
+		}
+		if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			violations = append(violations, "container "+container.Name+" allowPrivilegeEscalation=false değil")
+		}
+		if !podNonRoot && (sc.RunAsNonRoot == nil || !*sc.RunAsNonRoot) {
+			violations = append(violations, "container "+container.Name+" runAsNonRoot=true değil")
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			violations = append(violations, "container "+container.Name+" root kullanıcısıyla çalışıyor")
+		}
+		if !podSeccomp && (sc.SeccompProfile == nil || sc.SeccompProfile.Type == corev1.SeccompProfileTypeUnconfined) {
+			violations = append(violations, "container "+container.Name+" seccomp profili tanımlamıyor")
+		}
+		dropsAll := false
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Drop {
+				if capability == "ALL" {
+					dropsAll = true
+				}
+			}
+			for _, capability := range sc.Capabilities.Add {
+				if capability != "NET_BIND_SERVICE" {
+					violations = append(violations, fmt.Sprintf("container %s %s yetkisi ekliyor", container.Name, capability))
+				}
+			}
+		}
+		if !dropsAll {
+			violations = append(violations, "container "+container.Name+" tüm yetkileri (ALL) düşürmüyor")
+		}
+	}
+	return violations
+}
+
+// checkHostNamespaces, hostNetwork, hostPID ya da hostIPC kullanan iş
+// yüklerini varsa gerekçe annotation'larıyla birlikte listeler. Bilinen sistem
+// DaemonSet'lerinin bulunduğu namespace'ler kontrol dışı bırakılır.
+func (s *Suite) checkHostNamespaces(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Host namespace kontrolü için iş yüklerini", err)
+	}
+	excluded := map[string]bool{}
+	for _, namespace := range splitList(s.opts.HostNamespaceExcluded) {
+		excluded[namespace] = true
+	}
+	for _, source := range sources {
+		if excluded[source.namespace] {
+			continue
+		}
+		var used []string
+		if source.spec.HostNetwork {
+			used = append(used, "hostNetwork")
+		}
+		if source.spec.HostPID {
+			used = append(used, "hostPID")
+		}
+		if source.spec.HostIPC {
+			used = append(used, "hostIPC")
+		}
+		if len(used) == 0 {
+			continue
+		}
+		justification, ok := source.annotations[s.opts.HostNamespaceJustification]
+		if !ok {
+			justification = "gerekçe belirtilmemiş"
+		}
+		out.objectf(report.Info, source.ref(), "HostNamespace", "%s %s kullanıyor (%s)", source, strings.Join(used, ", "), justification)
+	}
+	return nil
+}
+
+// checkDangerousCapabilities, tehlikeli Linux yetkileri ekleyen ya da
+// allowPrivilegeEscalation ile çalışan container'ları namespace ve iş yükü
+// bazında gruplayarak raporlar.
+func (s *Suite) checkDangerousCapabilities(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Yetki denetimi için iş yüklerini", err)
+	}
+	dangerous := map[corev1.Capability]bool{}
+	for _, capability := range splitList(s.opts.DangerousCapabilities) {
+		dangerous[corev1.Capability(strings.ToUpper(capability))] = true
+	}
+
+	// usage, bir iş yükündeki tehlikeli yetki kullanımlarıdır; bulgular
+	// namespace bazında gruplanarak yazılır.
+	type usage struct {
+		source     podSpecSource
+		added      []string
+		escalation []string
+	}
+	usages := map[string][]usage{}
+	counts := map[string]int{}
+	for _, source := range sources {
+		var found usage
+		for _, container := range allContainers(source.spec) {
+			sc := container.SecurityContext
+			if sc == nil {
+				continue
+			}
+			if sc.Capabilities != nil {
+				for _, capability := range sc.Capabilities.Add {
+					if dangerous[capability] || capability == "ALL" {
+						found.added = append(found.added, fmt.Sprintf("container %s %s", container.Name, capability))
+					}
+				}
+			}
+			if sc.AllowPrivilegeEscalation != nil && *sc.AllowPrivilegeEscalation {
+				found.escalation = append(found.escalation, container.Name)
+			}
+		}
+		if len(found.added)+len(found.escalation) > 0 {
+			found.source = source
+			usages[source.namespace] = append(usages[source.namespace], found)
+			counts[source.namespace] += len(found.added) + len(found.escalation)
+		}
+	}
+
+	namespaces := make([]string, 0, len(usages))
+	for namespace := range usages {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		out.infof("Namespace %s içinde %d tehlikeli yetki kullanımı var:", namespace, counts[namespace])
+		for _, found := range usages[namespace] {
+			if len(found.added) > 0 {
+				out.objectf(report.Info, found.source.ref(), "DangerousCapability", "  %s tehlikeli yetki ekliyor: %s", found.source, strings.Join(found.added, ", "))
+			}
+			if len(found.escalation) > 0 {
+				out.objectf(report.Info, found.source.ref(), "AllowPrivilegeEscalation", "  %s container %s allowPrivilegeEscalation ile çalışıyor", found.source, strings.Join(found.escalation, ", "))
+			}
+		}
+	}
+	return nil
+}
+
+// checkImageRegistries, --allowed-registries listesindeki öneklerin dışında
+// kalan registry'lerden çekilen container image'larını raporlar.
+func (s *Suite) checkImageRegistries(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	allowed := splitList(s.opts.AllowedRegistries)
+	if len(allowed) == 0 {
+		return nil
+	}
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Registry kontrolü için iş yüklerini", err)
+	}
+	for _, source := range sources {
+		var denied []string
+		for _, container := range allContainers(source.spec) {
+			image := normalizeImage(container.Image)
+			permitted := false
+			for _, prefix := range allowed {
+				if image == prefix || strings.HasPrefix(image, strings.TrimSuffix(prefix, "/")+"/") {
+					permitted = true
+					break
+				}
+			}
+			if !permitted {
+				denied = append(denied, fmt.Sprintf("container %s: %s", container.Name, container.Image))
+			}
+		}
+		if len(denied) > 0 {
+			out.objectf(report.Info, source.ref(), "DisallowedRegistry", "%s izin verilmeyen bir registry'den image kullanıyor: %s", source, strings.Join(denied, ", "))
+		}
+	}
+	return nil
+}
+
+// normalizeImage, image referansını registry ile başlayan tam biçime çevirir;
+// örneğin "nginx" değeri "docker.io/library/nginx" olur.
+func normalizeImage(image string) string {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 1 {
+		return "docker.io/library/" + image
+	}
+	if !strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost" {
+		return "docker.io/" + image
+	}
+	return image
+}
+
+// checkVulnerabilities, trivy-operator kuruluysa VulnerabilityReport
+// nesnelerinden iş yükü başına kritik CVE sayılarını raporlar. --trivy-scan
+// verildiğinde cluster'da çalışan benzersiz image'lar yerel trivy ile arka
+// planda taranır; sonuçlar image digest'i başına --trivy-cache-ttl süresince
+// saklanır ve image'ı kullanan iş yükleriyle eşleştirilir.
+func (s *Suite) checkVulnerabilities(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	reports, found, err := servedResource(ctx, clientset, "aquasecurity.github.io", "vulnerabilityreports", "v1alpha1")
+	if err != nil {
+		out.infof("trivy-operator API sürümleri alınırken hata oluştu: %v", err)
+	} else if found {
+		list, err := listAll(ctx, s.opts, s.dynamic.Resource(reports).Namespace("").List, metav1.ListOptions{})
+		if err != nil {
+			out.infof("VulnerabilityReport'ları listelerken hata oluştu: %v", err)
+		} else {
+			perNamespace := map[string]int64{}
+			for _, vulnerabilityReport := range list.Items {
+				critical, _, _ := unstructured.NestedInt64(vulnerabilityReport.Object, "report", "summary", "criticalCount")
+				if critical == 0 {
+					continue
+				}
+				labels := vulnerabilityReport.GetLabels()
+				perNamespace[vulnerabilityReport.GetNamespace()] += critical
+				ref := report.ResourceRef{Kind: "VulnerabilityReport", Namespace: vulnerabilityReport.GetNamespace(), Name: vulnerabilityReport.GetName()}
+				out.objectf(report.Info, ref, "CriticalVulnerabilities", "%s %s/%s container %s: %d kritik CVE", labels["trivy-operator.resource.kind"], vulnerabilityReport.GetNamespace(), labels["trivy-operator.resource.name"], labels["trivy-operator.container.name"], critical)
+			}
+			namespaces := make([]string, 0, len(perNamespace))
+			for namespace := range perNamespace {
+				namespaces = append(namespaces, namespace)
+			}
+			sort.Strings(namespaces)
+			for _, namespace := range namespaces {
+				out.objectf(report.Info, namespaceRef(namespace), "CriticalVulnerabilities", "Namespace %s içinde toplam %d kritik CVE var", namespace, perNamespace[namespace])
+			}
+		}
+	}
+
+	if !s.opts.TrivyScan {
+		return nil
+	}
+	if s.trivy == nil {
+		s.trivy = newTrivyScanner(s.trivyCriticalCount, s.opts.TrivyCacheTTL, s.opts.TrivyScanTimeout)
+	}
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Image taraması için iş yüklerini", err)
+	}
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Image taraması için pod'ları", err)
+	}
+	digests := runningImageDigests(pods.Items)
+	users := map[string][]string{}
+	for _, source := range sources {
+		for _, container := range allContainers(source.spec) {
+			users[container.Image] = append(users[container.Image], source.String())
+		}
+	}
+	images := make([]string, 0, len(users))
+	for image := range users {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	for _, image := range images {
+		// Aynı etiket farklı digest'lere işaret edebildiğinden sonuçlar
+		// çalışan pod'lardan okunan digest'e göre saklanır; digest henüz
+		// bilinmiyorsa image adı kullanılır.
+		target := image
+		if digest, ok := digests[image]; ok {
+			target = digest
+		}
+		result, ok := s.trivy.lookup(target)
+		if !ok {
+			continue
+		}
+		if result.err != nil {
+			out.infof("Image %s trivy ile taranırken hata oluştu: %v", image, result.err)
+			continue
+		}
+		if result.critical > 0 {
+			out.infof("Image %s %d kritik CVE içeriyor, kullanan iş yükleri: %s", image, result.critical, strings.Join(users[image], ", "))
+		}
+	}
+	if pending := s.trivy.pendingCount(); pending > 0 {
+		out.infof("%d image arka planda trivy ile taranıyor; sonuçları sonraki döngülerde raporlanacak", pending)
+	}
+	return nil
+}
+
+// trivyCriticalCount, image'ı trivy ile tarar ve kritik zafiyet sayısını döndürür.
+func (s *Suite) trivyCriticalCount(ctx context.Context, image string) (int, error) {
+	output, err := exec.CommandContext(ctx, s.opts.TrivyBinary, "image", "--quiet", "--format", "json", "--severity", "CRITICAL", image).Output()
+	if err != nil {
+		return 0, err
+	}
+	var result struct {
+		Results []struct {
+			Vulnerabilities []json.RawMessage `json:"Vulnerabilities"`
+		} `json:"Results"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, r := range result.Results {
+		count += len(r.Vulnerabilities)
+	}
+	return count, nil
+}
+
+// checkSeccompAppArmor, seccomp profili (RuntimeDefault ya da özel) tanımlamayan
+// container'ları ve AppArmor'ın etkin olduğu node'larda AppArmor annotation'ı
+// taşımayan container'ları raporlar. AppArmor desteği, kubelet'in Ready
+// koşulu mesajında "AppArmor enabled" bildirmesinden anlaşılır.
+func (s *Suite) checkSeccompAppArmor(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Seccomp/AppArmor kontrolü için node'ları", err)
+	}
+	appArmorNodes := map[string]bool{}
+	for _, node := range nodes.Items {
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady && strings.Contains(condition.Message, "AppArmor enabled") {
+				appArmorNodes[node.Name] = true
+			}
+		}
+	}
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return checkerrors.ListFailed("Seccomp/AppArmor kontrolü için pod'ları", err)
+	}
+
+	withoutSeccomp, withoutAppArmor := 0, 0
+	for _, pod := range pods.Items {
+		podSeccomp := pod.Spec.SecurityContext != nil && pod.Spec.SecurityContext.SeccompProfile != nil &&
+			pod.Spec.SecurityContext.SeccompProfile.Type != corev1.SeccompProfileTypeUnconfined
+		var seccomp, appArmor []string
+		for _, container := range allContainers(&pod.Spec) {
+			sc := container.SecurityContext
+			containerSeccomp := sc != nil && sc.SeccompProfile != nil && sc.SeccompProfile.Type != corev1.SeccompProfileTypeUnconfined
+			if !podSeccomp && !containerSeccomp {
+				seccomp = append(seccomp, container.Name)
+			}
+			if !appArmorNodes[pod.Spec.NodeName] {
+				continue
+			}
+			// Kubernetes 1.30'dan itibaren profil securityContext.appArmorProfile
+			// alanında da verilebilir; eski annotation hâlâ desteklenir.
+			if profile := appArmorProfile(pod.Spec.SecurityContext, sc); profile != nil {
+				if profile.Type == corev1.AppArmorProfileTypeUnconfined {
+					appArmor = append(appArmor, container.Name)
+				}
+				continue
+			}
+			profile, ok := pod.Annotations[corev1.DeprecatedAppArmorBetaContainerAnnotationKeyPrefix+container.Name]
+			if !ok || profile == corev1.DeprecatedAppArmorBetaProfileNameUnconfined {
+				appArmor = append(appArmor, container.Name)
+			}
+		}
+		withoutSeccomp += len(seccomp)
+		withoutAppArmor += len(appArmor)
+		if len(seccomp) > 0 {
+			out.objectf(report.Info, podRef(&pod), "MissingSeccompProfile", "Pod %s namespace %s container %s seccomp profili olmadan çalışıyor", pod.Name, pod.Namespace, strings.Join(seccomp, ", "))
+		}
+		if len(appArmor) > 0 {
+			out.objectf(report.Info, podRef(&pod), "MissingAppArmorProfile", "Pod %s namespace %s container %s AppArmor profili olmadan çalışıyor", pod.Name, pod.Namespace, strings.Join(appArmor, ", "))
+		}
+	}
+	if withoutSeccomp+withoutAppArmor > 0 {
+		out.infof("Seccomp profili olmayan %d, AppArmor profili olmayan %d container var", withoutSeccomp, withoutAppArmor)
+	}
+	return nil
+}
+
+// appArmorProfile, container'ın securityContext.appArmorProfile alanını, yoksa
+// pod'unkini döndürür.
+func appArmorProfile(pod *corev1.PodSecurityContext, container *corev1.SecurityContext) *corev1.AppArmorProfile {
+	if container != nil && container.AppArmorProfile != nil {
+		return container.AppArmorProfile
+	}
+	if pod != nil {
+		return pod.AppArmorProfile
+	}
+	return nil
+}
+
+// checkLegacyServiceAccountTokens, süresi hiç dolmayan eski tip
+// kubernetes.io/service-account-token Secret'larını ve silinmiş
+// ServiceAccount'lara ait token'ları raporlar.
+func (s *Suite) checkLegacyServiceAccountTokens(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	secrets, err := listAll(ctx, s.opts, clientset.CoreV1().Secrets("").List, metav1.ListOptions{FieldSelector: "type=" + string(corev1.SecretTypeServiceAccountToken)})
+	if err != nil {
+		return checkerrors.ListFailed("ServiceAccount token Secret'larını", err)
+	}
+	if len(secrets.Items) == 0 {
+		return nil
+	}
+	serviceAccounts, err := s.listServiceAccounts(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("ServiceAccount'ları", err)
+	}
+	existing := map[string]bool{}
+	for _, sa := range serviceAccounts.Items {
+		existing[sa.Namespace+"/"+sa.Name] = true
+	}
+
+	for _, secret := range secrets.Items {
+		serviceAccount := secret.Annotations[corev1.ServiceAccountNameKey]
+		age := time.Since(secret.CreationTimestamp.Time).Round(time.Hour)
+		ref := report.ResourceRef{Kind: "Secret", Namespace: secret.Namespace, Name: secret.Name}
+		if !existing[secret.Namespace+"/"+serviceAccount] {
+			out.objectf(report.Critical, ref, "OrphanedLegacyToken", "Secret %s namespace %s silinmiş ServiceAccount %s için süresiz token içeriyor (yaş: %s)", secret.Name, secret.Namespace, serviceAccount, age)
+			continue
+		}
+		lastUsed := secret.Labels["kubernetes.io/legacy-token-last-used"]
+		if lastUsed == "" {
+			lastUsed = "bilinmiyor"
+		}
+		out.objectf(report.Info, ref, "LegacyToken", "Secret %s namespace %s ServiceAccount %s için süresiz token içeriyor (yaş: %s, son kullanım: %s)", secret.Name, secret.Namespace, serviceAccount, age, lastUsed)
+	}
+	return nil
+}
+
+// checkGatekeeper, OPA Gatekeeper kuruluysa tüm constraint'lerin audit
+// sonuçlarını okur ve ihlalleri raporlar. Önem derecesi --gatekeeper-severity
+// ile constraint adı ya da türü bazında belirlenir; belirtilmemişse
+// enforcementAction değerinden türetilir (deny: kritik, warn: uyarı).
+func (s *Suite) checkGatekeeper(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	groupVersion := "constraints.gatekeeper.sh/v1beta1"
+	resources, err := clientset.Discovery().ServerResourcesForGroupVersion(groupVersion)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Gatekeeper constraint türleri alınırken hata oluştu")
+	}
+	severities := map[string]string{}
+	for _, entry := range splitList(s.opts.GatekeeperSeverities) {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			severities[key] = value
+		}
+	}
+
+	for _, resource := range resources.APIResources {
+		if strings.Contains(resource.Name, "/") {
+			continue
+		}
+		gvr := schema.GroupVersionResource{Group: "constraints.gatekeeper.sh", Version: "v1beta1", Resource: resource.Name}
+		constraints, err := listAll(ctx, s.opts, s.dynamic.Resource(gvr).List, metav1.ListOptions{})
+		if err != nil {
+			out.infof("Gatekeeper %s constraint'lerini listelerken hata oluştu: %v", resource.Kind, err)
+			continue
+		}
+		for _, constraint := range constraints.Items {
+			total, _, _ := unstructured.NestedInt64(constraint.Object, "status", "totalViolations")
+			if total == 0 {
+				continue
+			}
+			action, _, _ := unstructured.NestedString(constraint.Object, "spec", "enforcementAction")
+			severity, ok := severities[constraint.GetName()]
+			if !ok {
+				severity, ok = severities[resource.Kind]
+			}
+			if !ok {
+				severity = map[string]string{"": "critical", "deny": "critical", "warn": "warning"}[action]
+			}
+			out.objectf(report.ParseSeverity(severity), report.ResourceRef{Kind: resource.Kind, Name: constraint.GetName()}, "ConstraintViolations", "Gatekeeper %s %s: %d ihlal", resource.Kind, constraint.GetName(), total)
+			violations, _, _ := unstructured.NestedSlice(constraint.Object, "status", "violations")
+			for _, item := range violations {
+				violation, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				kind, _, _ := unstructured.NestedString(violation, "kind")
+				name, _, _ := unstructured.NestedString(violation, "name")
+				namespace, _, _ := unstructured.NestedString(violation, "namespace")
+				message, _, _ := unstructured.NestedString(violation, "message")
+				out.objectf(report.Info, report.ResourceRef{Kind: kind, Namespace: namespace, Name: name}, "GatekeeperViolation", "  %s %s: %s", kind, namespacedName(namespace, name), message)
+			}
+		}
+	}
+	return nil
+}
+
+// checkPolicyReports, wgpolicyk8s.io PolicyReport ve ClusterPolicyReport
+// nesnelerindeki (Kyverno vb.) fail ve warn sonuçlarını raporlar. Yüksek ya da
+// kritik önemdeki başarısız sonuçlar kritik, diğerleri uyarı olarak yazılır.
+func (s *Suite) checkPolicyReports(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	for _, resource := range []string{"policyreports", "clusterpolicyreports"} {
+		gvr, found, err := servedResource(ctx, clientset, "wgpolicyk8s.io", resource, "v1alpha2")
+		if err != nil {
+			return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "PolicyReport API sürümleri alınırken hata oluştu")
+		}
+		if !found {
+			continue
+		}
+		reports, err := listAll(ctx, s.opts, s.dynamic.Resource(gvr).List, metav1.ListOptions{})
+		if err != nil {
+			out.infof("%s listelenirken hata oluştu: %v", resource, err)
+			continue
+		}
+		for _, policyReport := range reports.Items {
+			ref := report.ResourceRef{Kind: policyReport.GetKind(), Namespace: policyReport.GetNamespace(), Name: policyReport.GetName()}
+			// Aynı nesnenin birden fazla politika sonucu, en yüksek önem
+			// derecesiyle tek bir bulguda toplanır.
+			var order []report.ResourceRef
+			violations := map[report.ResourceRef]*policyViolations{}
+			results, _, _ := unstructured.NestedSlice(policyReport.Object, "results")
+			for _, item := range results {
+				result, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				outcome, _, _ := unstructured.NestedString(result, "result")
+				if outcome != "fail" && outcome != "warn" {
+					continue
+				}
+				policy, _, _ := unstructured.NestedString(result, "policy")
+				rule, _, _ := unstructured.NestedString(result, "rule")
+				message, _, _ := unstructured.NestedString(result, "message")
+				severity, _, _ := unstructured.NestedString(result, "severity")
+				level := report.Warning
+				if outcome == "fail" && (severity == "high" || severity == "critical") {
+					level = report.Critical
+				}
+				var targets []string
+				target := ref
+				objects, _, _ := unstructured.NestedSlice(result, "resources")
+				for _, object := range objects {
+					if fields, ok := object.(map[string]interface{}); ok {
+						kind, _, _ := unstructured.NestedString(fields, "kind")
+						name, _, _ := unstructured.NestedString(fields, "name")
+						namespace, _, _ := unstructured.NestedString(fields, "namespace")
+						targets = append(targets, kind+" "+namespacedName(namespace, name))
+						if len(objects) == 1 {
+							target = report.ResourceRef{Kind: kind, Namespace: namespace, Name: name}
+						}
+					}
+				}
+				v, ok := violations[target]
+				if !ok {
+					v = &policyViolations{}
+					violations[target] = v
+					order = append(order, target)
+				}
+				v.level = max(v.level, level)
+				v.results = append(v.results, fmt.Sprintf("%s/%s %s (%s): %s", policy, rule, outcome, strings.Join(targets, ", "), message))
+			}
+			for _, target := range order {
+				v := violations[target]
+				out.objectf(v.level, target, "PolicyViolation", "Politika sonuçları: %s", strings.Join(v.results, "; "))
+			}
+		}
+	}
+	return nil
+}
+
+// policyViolations, bir nesnenin PolicyReport'taki fail ve warn sonuçlarıdır.
+type policyViolations struct {
+	level   report.Severity
+	results []string
+}
+
+var (
+	// credentialEnvNamePattern, değeri düz metin olarak verildiğinde şüpheli sayılan ortam değişkeni adlarını yakalar.
+	credentialEnvNamePattern = regexp.MustCompile(`(?i)(PASSWORD|PASSWD|SECRET|TOKEN|API_?KEY|ACCESS_?KEY|PRIVATE_?KEY|CREDENTIAL)`)
+	// credentialValuePatterns, yaygın kimlik bilgisi biçimlerini yakalar.
+	credentialValuePatterns = []*regexp.Regexp{
+		regexp.MustCompile(`AKIA[0-9A-Z]{16}`),
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+		regexp.MustCompile(`gh[pousr]_[A-Za-z0-9]{36}`),
+		regexp.MustCompile(`xox[baprs]-[A-Za-z0-9-]+`),
+		regexp.MustCompile(`://[^/\s:@]+:[^/\s@]+@`),
+	}
+)
+
+// checkSecretEnvVars, Secret değerlerini dosya ya da CSI yerine düz ortam
+// değişkeni olarak alan container'ları ve ortam değişkenlerinde düz metin
+// kimlik bilgisi barındırdığından şüphelenilen iş yüklerini raporlar.
+// Şüpheli değerlerin kendisi hiçbir zaman yazdırılmaz.
+func (s *Suite) checkSecretEnvVars(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	sources, err := s.listPodSpecs(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Ortam değişkeni denetimi için iş yüklerini", err)
+	}
+	for _, source := range sources {
+		var fromSecrets, plaintext []string
+		for _, container := range allContainers(source.spec) {
+			for _, envFrom := range container.EnvFrom {
+				if envFrom.SecretRef != nil {
+					fromSecrets = append(fromSecrets, fmt.Sprintf("container %s Secret %s içeriğini", container.Name, envFrom.SecretRef.Name))
+				}
+			}
+			for _, env := range container.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+					fromSecrets = append(fromSecrets, fmt.Sprintf("container %s Secret %s anahtarını %s", container.Name, env.ValueFrom.SecretKeyRef.Name, env.Name))
+					continue
+				}
+				if env.Value == "" {
+					continue
+				}
+				suspicious := credentialEnvNamePattern.MatchString(env.Name)
+				for _, pattern := range credentialValuePatterns {
+					if pattern.MatchString(env.Value) {
+						suspicious = true
+					}
+				}
+				if suspicious {
+					plaintext = append(plaintext, fmt.Sprintf("container %s ortam değişkeni %s", container.Name, env.Name))
+				}
+			}
+		}
+		if len(fromSecrets) > 0 {
+			out.objectf(report.Info, source.ref(), "SecretInEnv", "%s Secret değerlerini ortam değişkeni olarak alıyor: %s", source, strings.Join(fromSecrets, "; "))
+		}
+		if len(plaintext) > 0 {
+			out.objectf(report.Warning, source.ref(), "PlaintextCredential", "%s düz metin kimlik bilgisi içeriyor olabilir: %s", source, strings.Join(plaintext, "; "))
+		}
+	}
+	return nil
+}
+
+// admissionWebhook, Validating ve Mutating webhook'ların bu kontrolde kullanılan ortak alanlarıdır.
+type admissionWebhook struct {
+	ref               report.ResourceRef
+	name              string
+	failurePolicy     *admissionregistrationv1.FailurePolicyType
+	service           *admissionregistrationv1.ServiceReference
+	namespaceSelector *metav1.LabelSelector
+}
+
+// checkWebhookRisks, failurePolicy=Fail olup arkasındaki Service'in hiç hazır
+// endpoint'i bulunmayan webhook'ları ve kube-system namespace'ini de kapsayan
+// webhook'ları raporlar. Her ikisi de cluster'ı kullanılamaz hale getirebilir.
+func (s *Suite) checkWebhookRisks(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	validating, err := listAll(ctx, s.opts, clientset.AdmissionregistrationV1().ValidatingWebhookConfigurations().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("ValidatingWebhookConfiguration'ları", err)
+	}
+	mutating, err := listAll(ctx, s.opts, clientset.AdmissionregistrationV1().MutatingWebhookConfigurations().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("MutatingWebhookConfiguration'ları", err)
+	}
+	var webhooks []admissionWebhook
+	for _, configuration := range validating.Items {
+		for _, webhook := range configuration.Webhooks {
+			webhooks = append(webhooks, admissionWebhook{report.ResourceRef{Kind: "ValidatingWebhookConfiguration", Name: configuration.Name}, webhook.Name, webhook.FailurePolicy, webhook.ClientConfig.Service, webhook.NamespaceSelector})
+		}
+	}
+	for _, configuration := range mutating.Items {
+		for _, webhook := range configuration.Webhooks {
+			webhooks = append(webhooks, admissionWebhook{report.ResourceRef{Kind: "MutatingWebhookConfiguration", Name: configuration.Name}, webhook.Name, webhook.FailurePolicy, webhook.ClientConfig.Service, webhook.NamespaceSelector})
+		}
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	readyEndpoints, err := s.readyEndpointCounts(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("EndpointSlice'ları", err)
+	}
+	kubeSystem, err := clientset.CoreV1().Namespaces().Get(ctx, metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "kube-system namespace'i alınırken hata oluştu")
+	}
+
+	// Bulgular webhook configuration başına ve nedene göre toplanır; bir
+	// configuration'ın birden fazla webhook'u aynı bulguda listelenir.
+	var configurations []report.ResourceRef
+	noEndpoints, failClosedKubeSystem, kubeSystemCovered := map[report.ResourceRef][]string{}, map[report.ResourceRef][]string{}, map[report.ResourceRef][]string{}
+	for _, webhook := range webhooks {
+		if len(configurations) == 0 || configurations[len(configurations)-1] != webhook.ref {
+			configurations = append(configurations, webhook.ref)
+		}
+		// v1 API'de failurePolicy belirtilmemişse varsayılan değer Fail'dir.
+		failClosed := webhook.failurePolicy == nil || *webhook.failurePolicy == admissionregistrationv1.Fail
+		if failClosed && webhook.service != nil && readyEndpoints[webhook.service.Namespace+"/"+webhook.service.Name] == 0 {
+			noEndpoints[webhook.ref] = append(noEndpoints[webhook.ref], fmt.Sprintf("%s (Service %s/%s)", webhook.name, webhook.service.Namespace, webhook.service.Name))
+		}
+		selector := labels.Everything()
+		if webhook.namespaceSelector != nil {
+			selector, err = metav1.LabelSelectorAsSelector(webhook.namespaceSelector)
+			if err != nil {
+				continue
+			}
+		}
+		if selector.Matches(labels.Set(kubeSystem.Labels)) {
+			if failClosed {
+				failClosedKubeSystem[webhook.ref] = append(failClosedKubeSystem[webhook.ref], webhook.name)
+			} else {
+				kubeSystemCovered[webhook.ref] = append(kubeSystemCovered[webhook.ref], webhook.name)
+			}
+		}
+	}
+	for _, ref := range configurations {
+		name := ref.Kind + " " + ref.Name
+		if names := noEndpoints[ref]; len(names) > 0 {
+			out.objectf(report.Critical, ref, "FailClosedNoEndpoints", "%s içindeki webhook'lar failurePolicy=Fail kullanıyor ve Service'leri hiç hazır endpoint'e sahip değil: %s", name, strings.Join(names, ", "))
+		}
+		if names := failClosedKubeSystem[ref]; len(names) > 0 {
+			out.objectf(report.Warning, ref, "FailClosedCoversKubeSystem", "%s içindeki webhook'lar kube-system namespace'ini de kapsıyor ve failurePolicy=Fail kullanıyor: %s", name, strings.Join(names, ", "))
+		}
+		if names := kubeSystemCovered[ref]; len(names) > 0 {
+			out.objectf(report.Info, ref, "CoversKubeSystem", "%s içindeki webhook'lar kube-system namespace'ini de kapsıyor: %s", name, strings.Join(names, ", "))
+		}
+	}
+	return nil
+}
+
+// anonymousSubjects, kimliği doğrulanmamış isteklere karşılık gelen RBAC subject'leridir.
+var anonymousSubjects = map[string]bool{
+	"User:system:anonymous":        true,
+	"Group:system:unauthenticated": true,
+}
+
+// anonymousAllowedRoles, kubeadm ve benzeri kurulumların anonim erişime
+// bilerek açtığı, yalnızca sağlık ve keşif bilgisi veren rollerdir.
+var anonymousAllowedRoles = map[string]bool{
+	"system:public-info-viewer": true,
+}
+
+// checkAnonymousAccess, system:anonymous ya da system:unauthenticated
+// subject'lerine yetki veren RoleBinding ve ClusterRoleBinding'leri kritik
+// bulgu olarak raporlar.
+func (s *Suite) checkAnonymousAccess(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	clusterRoleBindings, err := s.listClusterRoleBindings(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("ClusterRoleBinding'leri", err)
+	}
+	roleBindings, err := s.listRoleBindings(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("RoleBinding'leri", err)
+	}
+	for _, binding := range clusterRoleBindings.Items {
+		if anonymousAllowedRoles[binding.RoleRef.Name] {
+			continue
+		}
+		if subjects := anonymousBindingSubjects(binding.Subjects); subjects != "" {
+			out.objectf(report.Critical, report.ResourceRef{Kind: "ClusterRoleBinding", Name: binding.Name}, "AnonymousAccess", "ClusterRoleBinding %s, %s subject'ine %s %s yetkisi veriyor", binding.Name, subjects, binding.RoleRef.Kind, binding.RoleRef.Name)
+		}
+	}
+	for _, binding := range roleBindings.Items {
+		if subjects := anonymousBindingSubjects(binding.Subjects); subjects != "" {
+			out.objectf(report.Critical, report.ResourceRef{Kind: "RoleBinding", Namespace: binding.Namespace, Name: binding.Name}, "AnonymousAccess", "RoleBinding %s/%s, %s subject'ine %s %s yetkisi veriyor", binding.Namespace, binding.Name, subjects, binding.RoleRef.Kind, binding.RoleRef.Name)
+		}
+	}
+	return nil
+}
+
+// anonymousBindingSubjects, subjects içindeki anonim subject'leri virgülle
+// ayrılmış olarak döndürür.
+func anonymousBindingSubjects(subjects []rbacv1.Subject) string {
+	var anonymous []string
+	for _, subject := range subjects {
+		if anonymousSubjects[subjectString(subject)] {
+			anonymous = append(anonymous, subjectString(subject))
+		}
+	}
+	return strings.Join(anonymous, ", ")
+}
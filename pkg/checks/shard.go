@@ -0,0 +1,97 @@
+package checks
+
+import (
+	"context"
+	"hash/fnv"
+
+	"go-k8s-client/pkg/report"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NamespaceSharder, --shards birden büyükken namespace'lere göre
+// replica'lar arasında bölünebilen kontrollerin uyguladığı arayüzdür. Bu
+// kontroller yalnızca tek tek namespace'li nesneler hakkında bulgu üretir;
+// cluster geneli toplamlar üreten ya da kube-system gibi belirli
+// namespace'lere bakan kontroller bölünmez ve yalnızca liderde çalışır.
+type NamespaceSharder interface {
+	ShardedByNamespace() bool
+}
+
+// shardedCheck, kontrolü namespace'lere göre bölünebilir olarak işaretler.
+type shardedCheck struct {
+	Check
+}
+
+func (c shardedCheck) ShardedByNamespace() bool {
+	return true
+}
+
+func (c shardedCheck) Run(ctx context.Context, clientset kubernetes.Interface) ([]report.Finding, error) {
+	return c.Check.Run(context.WithValue(ctx, shardedContextKey{}, true), clientset)
+}
+
+// shardByNamespace, check'in --shards ile replica'lar arasında
+// bölünebileceğini belirtir.
+func shardByNamespace(check Check) Check {
+	return shardedCheck{Check: check}
+}
+
+// ShardByNamespace, bu paket dışında tanımlanan bir kontrolü namespace'lere
+// göre bölünebilir olarak işaretler; kontrol ListAll ile listelediğinde
+// yalnızca bu replica'nın namespace'lerini görür.
+func ShardByNamespace(check Check) Check {
+	return shardByNamespace(check)
+}
+
+// IsShardedByNamespace, check'in (sarılmışsa iç kontrolün) namespace'lere
+// göre bölünebilir olup olmadığını döndürür.
+func IsShardedByNamespace(check Check) bool {
+	sharder, ok := check.(NamespaceSharder)
+	return ok && sharder.ShardedByNamespace()
+}
+
+// shardedContextKey, bölünebilir bir kontrolün çalışması sırasında
+// context'e eklenir; list yardımcıları yalnızca bu durumda süzme yapar.
+type shardedContextKey struct{}
+
+func isShardedContext(ctx context.Context) bool {
+	sharded, _ := ctx.Value(shardedContextKey{}).(bool)
+	return sharded
+}
+
+// ownsNamespace, --shards birden büyükken namespace'in bu replica'nın
+// (--shard-index) payına düşüp düşmediğini döndürür. Namespace'ler adlarının
+// FNV-1a özetine göre replica'lara dağıtılır; böylece her replica aynı
+// namespace'i her döngüde aynı şekilde sahiplenir. Cluster genelindeki
+// nesneler (namespace'i boş olanlar) her zaman sahiplenilir.
+func ownsNamespace(opts Options, namespace string) bool {
+	if opts.Shards <= 1 || namespace == "" {
+		return true
+	}
+	hash := fnv.New32a()
+	hash.Write([]byte(namespace))
+	return int(hash.Sum32()%uint32(opts.Shards)) == opts.ShardIndex
+}
+
+// filtersShard, list yardımcılarının sonuçları bu replica'nın
+// namespace'lerine göre süzüp süzmeyeceğini döndürür. Kural listAll ve
+// informer cache'i için aynıdır: bölünebilir bir kontrol içinde belirli bir
+// namespace'in sorgusu dahil her sorgu süzülür.
+func filtersShard(ctx context.Context, opts Options) bool {
+	return opts.Shards > 1 && isShardedContext(ctx)
+}
+
+// shardObjects, objects içinden bu replica'nın namespace'lerine ait olanları
+// döndürür.
+func shardObjects(opts Options, objects []runtime.Object) []runtime.Object {
+	owned := objects[:0]
+	for _, object := range objects {
+		accessor, err := meta.Accessor(object)
+		if err != nil || ownsNamespace(opts, accessor.GetNamespace()) {
+			owned = append(owned, object)
+		}
+	}
+	return owned
+}
@@ -0,0 +1,986 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+)
+
+// checkPersistentVolumes, Released ya da Failed durumundaki PV'leri, claim'i
+// silindiği halde Retain politikası nedeniyle kalan PV'leri raporlar ve
+// StorageClass bazında toplam kapasiteyi yazar.
+func (s *Suite) checkPersistentVolumes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pvs, err := s.listPersistentVolumes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("PersistentVolume'leri", err)
+	}
+	pvcs, err := s.listPersistentVolumeClaims(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("PersistentVolumeClaim'leri", err)
+	}
+	claims := map[string]bool{}
+	for _, pvc := range pvcs.Items {
+		claims[pvc.Namespace+"/"+pvc.Name] = true
+	}
+	out.infof("Cluster'da %d PersistentVolume var", len(pvs.Items))
+
+	capacity := map[string]*resource.Quantity{}
+	for _, pv := range pvs.Items {
+		class := pv.Spec.StorageClassName
+		if class == "" {
+			class = "<yok>"
+		}
+		if size, ok := pv.Spec.Capacity[corev1.ResourceStorage]; ok {
+			if capacity[class] == nil {
+				capacity[class] = resource.NewQuantity(0, resource.BinarySI)
+			}
+			capacity[class].Add(size)
+		}
+
+		switch pv.Status.Phase {
+		case corev1.VolumeFailed:
+			out.objectf(report.Info, pvRef(pv.Name), string(corev1.VolumeFailed), "PersistentVolume %s Failed durumunda: %s", pv.Name, pv.Status.Message)
+		case corev1.VolumeReleased:
+			claim := ""
+			if pv.Spec.ClaimRef != nil {
+				claim = pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name
+			}
+			if pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain && !claims[claim] {
+				out.objectf(report.Info, pvRef(pv.Name), "RetainedAfterClaimDeleted", "PersistentVolume %s claim %s silindikten sonra Retain politikası nedeniyle sahipsiz kaldı", pv.Name, claim)
+			} else {
+				out.objectf(report.Info, pvRef(pv.Name), string(corev1.VolumeReleased), "PersistentVolume %s Released durumunda (claim: %s)", pv.Name, claim)
+			}
+		}
+	}
+
+	classes := make([]string, 0, len(capacity))
+	for class := range capacity {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		out.infof("StorageClass %s toplam PV kapasitesi: %s", class, capacity[class].String())
+	}
+	return nil
+}
+
+type phaseSince struct {
+	phase corev1.PersistentVolumePhase
+	since time.Time
+}
+
+// checkUnboundPersistentVolumes, --unbound-pv-threshold süresinden uzun süredir
+// Available ya da Released durumunda bekleyen PV'leri alttaki disk
+// kimlikleriyle birlikte raporlar; bunlar genellikle unutulmuş ve hâlâ
+// faturalanan cloud disklerdir. Available PV'ler için süre oluşturulma
+// zamanından, Released PV'ler için bu durumun ilk görüldüğü döngüden hesaplanır.
+func (s *Suite) checkUnboundPersistentVolumes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pvs, err := s.listPersistentVolumes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Bağlanmamış PV kontrolü için PersistentVolume'leri", err)
+	}
+	seen := map[string]bool{}
+	for _, pv := range pvs.Items {
+		if pv.Status.Phase != corev1.VolumeAvailable && pv.Status.Phase != corev1.VolumeReleased {
+			continue
+		}
+		seen[pv.Name] = true
+		state, ok := s.unboundPVSince[pv.Name]
+		if !ok || state.phase != pv.Status.Phase {
+			state = phaseSince{phase: pv.Status.Phase, since: time.Now()}
+			if pv.Status.Phase == corev1.VolumeAvailable {
+				state.since = pv.CreationTimestamp.Time
+			}
+			s.unboundPVSince[pv.Name] = state
+		}
+		if age := time.Since(state.since); age > s.opts.UnboundPVThreshold {
+			out.objectf(report.Info, pvRef(pv.Name), "Unbound", "PersistentVolume %s %s süredir %s durumunda (disk: %s)", pv.Name, age.Round(time.Minute), pv.Status.Phase, volumeID(&pv))
+		}
+	}
+	for name := range s.unboundPVSince {
+		if !seen[name] {
+			delete(s.unboundPVSince, name)
+		}
+	}
+	return nil
+}
+
+// volumeID, PV'nin arkasındaki depolama biriminin kimliğini döndürür.
+func volumeID(pv *corev1.PersistentVolume) string {
+	source := pv.Spec.PersistentVolumeSource
+	switch {
+	case source.CSI != nil:
+		return source.CSI.Driver + ":" + source.CSI.VolumeHandle
+	case source.AWSElasticBlockStore != nil:
+		return "aws-ebs:" + source.AWSElasticBlockStore.VolumeID
+	case source.GCEPersistentDisk != nil:
+		return "gce-pd:" + source.GCEPersistentDisk.PDName
+	case source.AzureDisk != nil:
+		return "azure-disk:" + source.AzureDisk.DataDiskURI
+	case source.NFS != nil:
+		return "nfs:" + source.NFS.Server + ":" + source.NFS.Path
+	case source.HostPath != nil:
+		return "hostPath:" + source.HostPath.Path
+	case source.Local != nil:
+		return "local:" + source.Local.Path
+	}
+	return "bilinmiyor"
+}
+
+// pendingClaimLookup, Pending PVC'lerin nedenlerini açıklamak için gereken
+// StorageClass'ları, CSIDriver kayıtlarını ve ProvisioningFailed event'lerini
+// her PVC için ayrı ayrı almak yerine döngü başına bir kez listeleyip saklar.
+type pendingClaimLookup struct {
+	classes      map[string]*storagev1.StorageClass
+	defaultClass *storagev1.StorageClass
+	classesErr   error
+	// drivers, listelenemediyse nil kalır ve CSIDriver kaydı denetlenmez.
+	drivers map[string]bool
+	// provisioningFailed, "namespace/ad" anahtarıyla PVC'lerin en son
+	// ProvisioningFailed event'ini tutar.
+	provisioningFailed map[string]corev1.Event
+}
+
+func (s *Suite) newPendingClaimLookup(ctx context.Context, clientset kubernetes.Interface) *pendingClaimLookup {
+	lookup := &pendingClaimLookup{classes: map[string]*storagev1.StorageClass{}, provisioningFailed: map[string]corev1.Event{}}
+	classes, err := s.listStorageClasses(ctx, clientset)
+	if err != nil {
+		lookup.classesErr = err
+	} else {
+		for i := range classes.Items {
+			class := &classes.Items[i]
+			lookup.classes[class.Name] = class
+			if isDefaultStorageClass(class) {
+				lookup.defaultClass = class
+			}
+		}
+	}
+	if drivers, err := listAll(ctx, s.opts, clientset.StorageV1().CSIDrivers().List, metav1.ListOptions{}); err == nil {
+		lookup.drivers = map[string]bool{}
+		for _, driver := range drivers.Items {
+			lookup.drivers[driver.Name] = true
+		}
+	}
+	selector := fields.Set{"involvedObject.kind": "PersistentVolumeClaim", "reason": "ProvisioningFailed"}.String()
+	if events, err := s.listEvents(ctx, clientset, selector, "ProvisioningFailed"); err == nil {
+		for _, event := range events {
+			key := event.InvolvedObject.Namespace + "/" + event.InvolvedObject.Name
+			if latest, ok := lookup.provisioningFailed[key]; !ok || event.LastTimestamp.After(latest.LastTimestamp.Time) {
+				lookup.provisioningFailed[key] = event
+			}
+		}
+	}
+	return lookup
+}
+
+// reason, Pending durumundaki bir PVC'nin neden bağlanmadığını
+// StorageClass'ı, provisioner'ın CSIDriver kaydını ve ProvisioningFailed
+// event'lerini inceleyerek açıklar.
+func (l *pendingClaimLookup) reason(pvc *corev1.PersistentVolumeClaim) string {
+	var class *storagev1.StorageClass
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != "" {
+		if l.classesErr != nil {
+			return fmt.Sprintf("StorageClass'lar listelenirken hata oluştu: %v", l.classesErr)
+		}
+		if pvc.Spec.StorageClassName == nil {
+			if l.defaultClass == nil {
+				return "StorageClass belirtilmemiş ve varsayılan StorageClass yok"
+			}
+			class = l.defaultClass
+		} else if class = l.classes[*pvc.Spec.StorageClassName]; class == nil {
+			return fmt.Sprintf("StorageClass %s bulunamadı", *pvc.Spec.StorageClassName)
+		}
+	}
+
+	if event, ok := l.provisioningFailed[pvc.Namespace+"/"+pvc.Name]; ok {
+		return "ProvisioningFailed: " + event.Message
+	}
+
+	if class == nil {
+		return "StorageClass'ı boş, elle oluşturulmuş bir PV bekleniyor"
+	}
+	if class.VolumeBindingMode != nil && *class.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+		return fmt.Sprintf("StorageClass %s WaitForFirstConsumer kullanıyor, PVC'yi kullanan bir pod bekleniyor", class.Name)
+	}
+	if !strings.HasPrefix(class.Provisioner, "kubernetes.io/") && l.drivers != nil && !l.drivers[class.Provisioner] {
+		return fmt.Sprintf("StorageClass %s provisioner'ı %s için kayıtlı bir CSIDriver yok", class.Name, class.Provisioner)
+	}
+	return fmt.Sprintf("provisioner %s henüz bir volume oluşturmadı", class.Provisioner)
+}
+
+// isDefaultStorageClass, StorageClass'ın varsayılan olarak işaretlenip işaretlenmediğini döndürür.
+func isDefaultStorageClass(class *storagev1.StorageClass) bool {
+	return class.Annotations["storageclass.kubernetes.io/is-default-class"] == "true" ||
+		class.Annotations["storageclass.beta.kubernetes.io/is-default-class"] == "true"
+}
+
+// checkStorageClasses, tam olarak bir varsayılan StorageClass olduğunu
+// doğrular, çalışan bir CSI sürücüsü olmayan provisioner'lara işaret eden
+// StorageClass'ları ve var olmayan bir StorageClass kullanan PVC'leri raporlar.
+func (s *Suite) checkStorageClasses(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	classes, err := s.listStorageClasses(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("StorageClass'ları", err)
+	}
+	csiNodes, err := listAll(ctx, s.opts, clientset.StorageV1().CSINodes().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("CSINode'ları", err)
+	}
+	runningDrivers := map[string]bool{}
+	for _, csiNode := range csiNodes.Items {
+		for _, driver := range csiNode.Spec.Drivers {
+			runningDrivers[driver.Name] = true
+		}
+	}
+
+	var defaults []string
+	classNames := map[string]bool{}
+	for _, class := range classes.Items {
+		classNames[class.Name] = true
+		if isDefaultStorageClass(&class) {
+			defaults = append(defaults, class.Name)
+		}
+		if !strings.HasPrefix(class.Provisioner, "kubernetes.io/") && !runningDrivers[class.Provisioner] {
+			out.objectf(report.Info, report.ResourceRef{Kind: "StorageClass", Name: class.Name}, "ProvisionerNotRunning", "StorageClass %s provisioner'ı %s hiçbir node'da çalışan bir CSI sürücüsüne sahip değil", class.Name, class.Provisioner)
+		}
+	}
+	switch len(defaults) {
+	case 0:
+		out.infof("Cluster'da varsayılan StorageClass yok")
+	case 1:
+	default:
+		out.infof("Cluster'da birden fazla varsayılan StorageClass var: %s", strings.Join(defaults, ", "))
+	}
+
+	pvcs, err := s.listPersistentVolumeClaims(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("PersistentVolumeClaim'leri", err)
+	}
+	for _, pvc := range pvcs.Items {
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" && !classNames[*pvc.Spec.StorageClassName] {
+			out.objectf(report.Info, pvcRef(pvc.Namespace, pvc.Name), "MissingStorageClass", "PersistentVolumeClaim %s namespace %s var olmayan StorageClass %s kullanıyor", pvc.Name, pvc.Namespace, *pvc.Spec.StorageClassName)
+		}
+	}
+	return nil
+}
+
+// checkVolumeUsage, kubelet özet API'sinden PVC başına dosya sistemi
+// kullanımını toplar; --volume-usage-threshold değerini aşan ya da CSI
+// sürücüsünün anormal olarak işaretlediği volume'leri, pod'lar yazma
+// hataları almaya başlamadan önce raporlar.
+func (s *Suite) checkVolumeUsage(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return checkerrors.ListFailed("Volume kullanımı için pod'ları", err)
+	}
+	nodes := map[string]bool{}
+	for _, pod := range pods.Items {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				nodes[pod.Spec.NodeName] = true
+			}
+		}
+	}
+
+	reported := map[string]bool{}
+	for nodeName := range nodes {
+		summary, err := getKubeletSummary(ctx, clientset, nodeName)
+		if err != nil {
+			out.infof("Node %s için kubelet istatistikleri alınırken hata oluştu: %v", nodeName, err)
+			continue
+		}
+		for _, podStats := range summary.Pods {
+			for _, volume := range podStats.Volumes {
+				if volume.PVCRef == nil {
+					continue
+				}
+				key := volume.PVCRef.Namespace + "/" + volume.PVCRef.Name
+				if reported[key] {
+					continue
+				}
+				reported[key] = true
+				if volume.VolumeHealthStats != nil && volume.VolumeHealthStats.Abnormal {
+					out.objectf(report.Critical, pvcRef(volume.PVCRef.Namespace, volume.PVCRef.Name), "VolumeAbnormal", "PersistentVolumeClaim %s namespace %s CSI sürücüsü tarafından anormal olarak bildirildi", volume.PVCRef.Name, volume.PVCRef.Namespace)
+				}
+				if volume.UsedBytes == nil || volume.CapacityBytes == nil || *volume.CapacityBytes == 0 {
+					continue
+				}
+				usage := float64(*volume.UsedBytes) / float64(*volume.CapacityBytes) * 100
+				if usage >= s.opts.VolumeUsageThreshold {
+					out.objectf(report.Warning, pvcRef(volume.PVCRef.Namespace, volume.PVCRef.Name), "VolumeUsageHigh", "PersistentVolumeClaim %s namespace %s kapasitesinin %%%.0f kadarı dolu", volume.PVCRef.Name, volume.PVCRef.Namespace, usage)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkVolumeSnapshots, snapshot CRD'leri kuruluysa --snapshot-stuck-threshold
+// süresinden uzun süredir hazır olmayan VolumeSnapshot/VolumeSnapshotContent
+// nesnelerini ve kayıtlı olmayan bir sürücüye işaret eden VolumeSnapshotClass'ları raporlar.
+func (s *Suite) checkVolumeSnapshots(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	snapshots, found, err := servedResource(ctx, clientset, "snapshot.storage.k8s.io", "volumesnapshots", "v1")
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeRequestFailed, err, "Snapshot API sürümleri alınırken hata oluştu")
+	}
+	if !found {
+		return nil
+	}
+
+	for _, resource := range []string{"volumesnapshots", "volumesnapshotcontents"} {
+		list, err := listAll(ctx, s.opts, s.dynamic.Resource(snapshots.GroupVersion().WithResource(resource)).List, metav1.ListOptions{})
+		if err != nil {
+			out.infof("%s listelenirken hata oluştu: %v", resource, err)
+			continue
+		}
+		for _, item := range list.Items {
+			ready, _, _ := unstructured.NestedBool(item.Object, "status", "readyToUse")
+			if ready {
+				continue
+			}
+			message, _, _ := unstructured.NestedString(item.Object, "status", "error", "message")
+			age := time.Since(item.GetCreationTimestamp().Time)
+			if message != "" || age > s.opts.SnapshotStuckThreshold {
+				out.objectf(report.Info, report.ResourceRef{Kind: item.GetKind(), Namespace: item.GetNamespace(), Name: item.GetName()}, "NotReadyToUse", "%s %s %s süredir hazır değil: %s", item.GetKind(), namespacedName(item.GetNamespace(), item.GetName()), age.Round(time.Second), message)
+			}
+		}
+	}
+
+	classes, err := listAll(ctx, s.opts, s.dynamic.Resource(snapshots.GroupVersion().WithResource("volumesnapshotclasses")).List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("VolumeSnapshotClass'ları", err)
+	}
+	drivers, err := listAll(ctx, s.opts, clientset.StorageV1().CSIDrivers().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("CSIDriver'ları", err)
+	}
+	registered := map[string]bool{}
+	for _, driver := range drivers.Items {
+		registered[driver.Name] = true
+	}
+	for _, class := range classes.Items {
+		driver, _, _ := unstructured.NestedString(class.Object, "driver")
+		if !registered[driver] {
+			out.objectf(report.Info, report.ResourceRef{Kind: "VolumeSnapshotClass", Name: class.GetName()}, "UnregisteredDriver", "VolumeSnapshotClass %s kayıtlı olmayan sürücü %s kullanıyor", class.GetName(), driver)
+		}
+	}
+	return nil
+}
+
+// namespacedName, namespace'li nesneler için "namespace/ad", cluster
+// kapsamındaki nesneler için yalnızca adı döndürür.
+func pvRef(name string) report.ResourceRef {
+	return report.ResourceRef{Kind: "PersistentVolume", Name: name}
+}
+
+func pvcRef(namespace, name string) report.ResourceRef {
+	return report.ResourceRef{Kind: "PersistentVolumeClaim", Namespace: namespace, Name: name}
+}
+
+func namespacedName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+// checkCSIDrivers, her CSIDriver için sağlıklı bir controller iş yükü ve node
+// DaemonSet'i bulunduğunu doğrular ve CSINode nesnesinde sürücüyü taşımayan
+// node'ları listeler. İş yükleri, CSI sidecar'larını içermelerine ve pod
+// tanımlarında sürücü adına referans vermelerine göre eşleştirilir.
+func (s *Suite) checkCSIDrivers(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	drivers, err := listAll(ctx, s.opts, clientset.StorageV1().CSIDrivers().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("CSIDriver'ları", err)
+	}
+	if len(drivers.Items) == 0 {
+		return nil
+	}
+	csiNodes, err := listAll(ctx, s.opts, clientset.StorageV1().CSINodes().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("CSINode'ları", err)
+	}
+	daemonSets, err := s.listDaemonSets(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("DaemonSet'leri", err)
+	}
+	deployments, err := s.listDeployments(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Deployment'ları", err)
+	}
+	statefulSets, err := s.listStatefulSets(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("StatefulSet'leri", err)
+	}
+
+	for _, driver := range drivers.Items {
+		driverRef := report.ResourceRef{Kind: "CSIDriver", Name: driver.Name}
+		nodePluginFound := false
+		for _, ds := range daemonSets.Items {
+			if !podSpecHasImage(&ds.Spec.Template.Spec, "node-driver-registrar") || !podSpecMentions(&ds.Spec.Template.Spec, driver.Name) {
+				continue
+			}
+			nodePluginFound = true
+			if ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+				out.objectf(report.Info, report.ResourceRef{Kind: "DaemonSet", Namespace: ds.Namespace, Name: ds.Name}, "CSINodePluginNotReady", "CSI sürücüsü %s node DaemonSet'i %s/%s %d/%d hazır", driver.Name, ds.Namespace, ds.Name, ds.Status.NumberReady, ds.Status.DesiredNumberScheduled)
+			}
+		}
+		if !nodePluginFound {
+			out.objectf(report.Info, driverRef, "NodePluginMissing", "CSI sürücüsü %s için node DaemonSet'i bulunamadı", driver.Name)
+		}
+
+		controllerFound := false
+		for _, deployment := range deployments.Items {
+			if isCSIController(&deployment.Spec.Template.Spec, driver.Name) {
+				controllerFound = true
+				if deployment.Status.ReadyReplicas == 0 {
+					out.objectf(report.Info, report.ResourceRef{Kind: "Deployment", Namespace: deployment.Namespace, Name: deployment.Name}, "CSIControllerNotReady", "CSI sürücüsü %s controller Deployment'ı %s/%s hiç hazır replica'ya sahip değil", driver.Name, deployment.Namespace, deployment.Name)
+				}
+			}
+		}
+		for _, statefulSet := range statefulSets.Items {
+			if isCSIController(&statefulSet.Spec.Template.Spec, driver.Name) {
+				controllerFound = true
+				if statefulSet.Status.ReadyReplicas == 0 {
+					out.objectf(report.Info, report.ResourceRef{Kind: "StatefulSet", Namespace: statefulSet.Namespace, Name: statefulSet.Name}, "CSIControllerNotReady", "CSI sürücüsü %s controller StatefulSet'i %s/%s hiç hazır replica'ya sahip değil", driver.Name, statefulSet.Namespace, statefulSet.Name)
+				}
+			}
+		}
+		attachRequired := driver.Spec.AttachRequired == nil || *driver.Spec.AttachRequired
+		if !controllerFound && attachRequired {
+			out.objectf(report.Info, driverRef, "ControllerMissing", "CSI sürücüsü %s için controller iş yükü bulunamadı", driver.Name)
+		}
+
+		var missing []string
+		for _, csiNode := range csiNodes.Items {
+			found := false
+			for _, d := range csiNode.Spec.Drivers {
+				if d.Name == driver.Name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				missing = append(missing, csiNode.Name)
+			}
+		}
+		if len(missing) > 0 {
+			out.objectf(report.Info, driverRef, "NotRegisteredOnNodes", "CSI sürücüsü %s şu node'larda kayıtlı değil: %s", driver.Name, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
+
+// isCSIController, pod tanımının CSI controller sidecar'larından birini
+// içerip verilen sürücüye referans verip vermediğini döndürür.
+func isCSIController(spec *corev1.PodSpec, driver string) bool {
+	return (podSpecHasImage(spec, "csi-provisioner") || podSpecHasImage(spec, "csi-attacher")) && podSpecMentions(spec, driver)
+}
+
+// podSpecHasImage, pod tanımındaki container'lardan birinin image'ının verilen metni içerip içermediğini döndürür.
+func podSpecHasImage(spec *corev1.PodSpec, image string) bool {
+	for _, container := range spec.Containers {
+		if strings.Contains(container.Image, image) {
+			return true
+		}
+	}
+	return false
+}
+
+// podSpecMentions, sürücü adının container argümanlarında, ortam
+// değişkenlerinde ya da hostPath volume'lerinde geçip geçmediğini döndürür.
+// external-provisioner, leader-election adlarında noktalar yerine tire kullandığından
+// bu biçim de aranır.
+func podSpecMentions(spec *corev1.PodSpec, driver string) bool {
+	candidates := []string{driver, strings.ReplaceAll(driver, ".", "-")}
+	mentions := func(value string) bool {
+		for _, candidate := range candidates {
+			if strings.Contains(value, candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, container := range spec.Containers {
+		for _, value := range append(append([]string{}, container.Command...), container.Args...) {
+			if mentions(value) {
+				return true
+			}
+		}
+		for _, env := range container.Env {
+			if mentions(env.Value) {
+				return true
+			}
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil && mentions(volume.HostPath.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkStuckResizes, Resizing ya da FileSystemResizePending koşulu
+// --resize-stuck-threshold süresinden uzun süredir devam eden PVC'leri ilgili
+// event'lerle birlikte raporlar. Takılan genişletmeler uygulamaları sessizce
+// diskten yoksun bırakır.
+func (s *Suite) checkStuckResizes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pvcs, err := s.listPersistentVolumeClaims(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Genişletme kontrolü için PersistentVolumeClaim'leri", err)
+	}
+	for _, pvc := range pvcs.Items {
+		for _, condition := range pvc.Status.Conditions {
+			if condition.Type != corev1.PersistentVolumeClaimResizing && condition.Type != corev1.PersistentVolumeClaimFileSystemResizePending {
+				continue
+			}
+			if condition.Status != corev1.ConditionTrue {
+				continue
+			}
+			age := time.Since(condition.LastTransitionTime.Time)
+			if age < s.opts.ResizeStuckThreshold {
+				continue
+			}
+			requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			current := pvc.Status.Capacity[corev1.ResourceStorage]
+			out.objectf(report.Info, pvcRef(pvc.Namespace, pvc.Name), string(condition.Type), "PersistentVolumeClaim %s namespace %s %s süredir %s durumunda (%s -> %s)", pvc.Name, pvc.Namespace, age.Round(time.Second), condition.Type, current.String(), requested.String())
+
+			events, err := listAll(ctx, s.opts, clientset.CoreV1().Events(pvc.Namespace).List, metav1.ListOptions{
+				FieldSelector: fields.Set{"involvedObject.kind": "PersistentVolumeClaim", "involvedObject.name": pvc.Name}.String(),
+			})
+			if err != nil {
+				out.infof("PersistentVolumeClaim %s event'lerini listelerken hata oluştu: %v", pvc.Name, err)
+				continue
+			}
+			for _, event := range events.Items {
+				if event.Type == corev1.EventTypeWarning {
+					out.infof("  %s: %s", event.Reason, event.Message)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkAccessModes, ReadWriteMany desteklemeyen provisioner'lara sahip
+// StorageClass'lardan ReadWriteMany isteyen PVC'leri ve birden fazla replica'lı
+// Deployment'lar tarafından bağlanan yalnızca ReadWriteOnce PVC'leri raporlar.
+func (s *Suite) checkAccessModes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	classes, err := s.listStorageClasses(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Erişim modu kontrolü için StorageClass'ları", err)
+	}
+	pvcs, err := s.listPersistentVolumeClaims(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Erişim modu kontrolü için PersistentVolumeClaim'leri", err)
+	}
+	deployments, err := s.listDeployments(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Erişim modu kontrolü için Deployment'ları", err)
+	}
+
+	blockOnly := map[string]bool{}
+	for _, provisioner := range strings.Split(s.opts.BlockOnlyProvisioners, ",") {
+		blockOnly[strings.TrimSpace(provisioner)] = true
+	}
+	provisioners := map[string]string{}
+	for _, class := range classes.Items {
+		provisioners[class.Name] = class.Provisioner
+	}
+
+	rwoOnly := map[string]bool{}
+	for _, pvc := range pvcs.Items {
+		modes := map[corev1.PersistentVolumeAccessMode]bool{}
+		for _, mode := range pvc.Spec.AccessModes {
+			modes[mode] = true
+		}
+		if modes[corev1.ReadWriteMany] && pvc.Spec.StorageClassName != nil {
+			if provisioner := provisioners[*pvc.Spec.StorageClassName]; blockOnly[provisioner] {
+				out.objectf(report.Info, pvcRef(pvc.Namespace, pvc.Name), "UnsupportedReadWriteMany", "PersistentVolumeClaim %s namespace %s ReadWriteMany istiyor ancak %s provisioner'ı bunu desteklemiyor", pvc.Name, pvc.Namespace, provisioner)
+			}
+		}
+		if !modes[corev1.ReadWriteMany] && !modes[corev1.ReadOnlyMany] {
+			rwoOnly[pvc.Namespace+"/"+pvc.Name] = true
+		}
+	}
+
+	for _, deployment := range deployments.Items {
+		if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas <= 1 {
+			continue
+		}
+		for _, volume := range deployment.Spec.Template.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			if rwoOnly[deployment.Namespace+"/"+volume.PersistentVolumeClaim.ClaimName] {
+				out.objectf(report.Info, pvcRef(deployment.Namespace, volume.PersistentVolumeClaim.ClaimName), "SharedReadWriteOnce", "Deployment %s namespace %s %d replica ile ReadWriteOnce PVC %s bağlıyor", deployment.Name, deployment.Namespace, *deployment.Spec.Replicas, volume.PersistentVolumeClaim.ClaimName)
+			}
+		}
+	}
+	return nil
+}
+
+// statefulSetPVCPattern, volumeClaimTemplates'tan oluşturulan "<şablon>-<statefulset>-<sıra>" adlarını yakalar.
+var statefulSetPVCPattern = regexp.MustCompile(`^(.+)-(\d+)$`)
+
+// checkOrphanedStatefulSetPVCs, volumeClaimTemplates ile oluşturulmuş PVC'leri
+// inceler. Sırası (ordinal) replica sayısının dışında kalan PVC'ler yalnızca
+// raporlanır: StatefulSet bunları, yeniden büyütüldüğünde aynı sıradaki pod'a
+// verileriyle birlikte bağlamak için bilerek saklar. StatefulSet'i tamamen
+// silinmiş PVC'ler ise sahipsizdir. Varsayılan Retain politikasında bu
+// PVC'lerin ownerReference'ı olmadığından, "<şablon>-<statefulset>" önekleri
+// döngüler arasında hatırlanır ve önceki döngüde bir StatefulSet'e ait olan
+// ama artık hiçbir StatefulSet'in şablonuyla eşleşmeyen PVC'ler sahipsiz
+// sayılır. --delete-orphaned-statefulset-pvcs verildiğinde yalnızca bu
+// PVC'ler, hiçbir pod tarafından kullanılmıyorsa ve UID'leri değişmemişse
+// silinir.
+func (s *Suite) checkOrphanedStatefulSetPVCs(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	statefulSets, err := s.listStatefulSets(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("StatefulSet'leri", err)
+	}
+	pvcs, err := s.listPersistentVolumeClaims(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("PersistentVolumeClaim'leri", err)
+	}
+	mounted, err := s.mountedClaims(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("Pod'ları", err)
+	}
+
+	// prefixes, "namespace/<şablon>-<statefulset>" önekini StatefulSet'in replica sayısına eşler.
+	prefixes := map[string]int32{}
+	existing := map[string]bool{}
+	for _, statefulSet := range statefulSets.Items {
+		existing[statefulSet.Namespace+"/"+statefulSet.Name] = true
+		replicas := int32(1)
+		if statefulSet.Spec.Replicas != nil {
+			replicas = *statefulSet.Spec.Replicas
+		}
+		for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+			prefix := statefulSet.Namespace + "/" + template.Name + "-" + statefulSet.Name
+			prefixes[prefix] = replicas
+		}
+	}
+	previous := s.statefulSetClaimPrefixes
+	s.statefulSetClaimPrefixes = map[string]string{}
+	for _, statefulSet := range statefulSets.Items {
+		for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+			s.statefulSetClaimPrefixes[statefulSet.Namespace+"/"+template.Name+"-"+statefulSet.Name] = statefulSet.Name
+		}
+	}
+
+	for _, pvc := range pvcs.Items {
+		key := pvc.Namespace + "/" + pvc.Name
+		match := statefulSetPVCPattern.FindStringSubmatch(pvc.Name)
+		if match == nil || pvc.DeletionTimestamp != nil {
+			continue
+		}
+		ref := pvcRef(pvc.Namespace, pvc.Name)
+		prefix := pvc.Namespace + "/" + match[1]
+		ordinal, _ := strconv.Atoi(match[2])
+		if replicas, ok := prefixes[prefix]; ok {
+			if int32(ordinal) >= replicas && !mounted[key] {
+				out.objectf(report.Info, ref, "ScaledDownOrdinal", "PersistentVolumeClaim %s namespace %s küçültülmüş StatefulSet'in %d. sırasına ait (replica: %d); StatefulSet yeniden büyütüldüğünde bağlanacağı için silinmez", pvc.Name, pvc.Namespace, ordinal, replicas)
+			}
+			continue
+		}
+
+		statefulSet, known := previous[prefix]
+		if owner := metav1.GetControllerOf(&pvc); owner != nil && owner.Kind == "StatefulSet" && !existing[pvc.Namespace+"/"+owner.Name] {
+			statefulSet, known = owner.Name, true
+		}
+		if !known {
+			continue
+		}
+		if mounted[key] {
+			out.objectf(report.Info, ref, "StatefulSetDeleted", "PersistentVolumeClaim %s namespace %s silinmiş StatefulSet %s'e ait ancak hâlâ bir pod tarafından kullanılıyor", pvc.Name, pvc.Namespace, statefulSet)
+			continue
+		}
+		out.objectf(report.Info, ref, "StatefulSetDeleted", "PersistentVolumeClaim %s namespace %s sahipsiz: StatefulSet %s silinmiş", pvc.Name, pvc.Namespace, statefulSet)
+		if s.opts.DeleteOrphanedStatefulSetPVCs {
+			uid := pvc.UID
+			err := clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(ctx, pvc.Name, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}})
+			if err != nil {
+				out.infof("PersistentVolumeClaim %s silinirken hata oluştu: %v", pvc.Name, err)
+				continue
+			}
+			out.infof("PersistentVolumeClaim %s namespace %s silindi", pvc.Name, pvc.Namespace)
+		}
+	}
+	return nil
+}
+
+// mountedClaims, tamamlanmamış pod'lar tarafından kullanılan PVC'leri
+// namespace/ad anahtarıyla döndürür. Henüz başlamamış (Pending) pod'lar
+// volume'ü birazdan bağlayacağından, sonlanmakta olan pod'lar ise volume'ü
+// hâlâ bağlı tuttuğundan kullanıyor sayılır. Generic ephemeral volume'ler
+// için oluşturulan "<pod>-<volume>" PVC'leri de dahildir.
+func (s *Suite) mountedClaims(ctx context.Context, clientset kubernetes.Interface) (map[string]bool, error) {
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	mounted := map[string]bool{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			switch {
+			case volume.PersistentVolumeClaim != nil:
+				mounted[pod.Namespace+"/"+volume.PersistentVolumeClaim.ClaimName] = true
+			case volume.Ephemeral != nil:
+				mounted[pod.Namespace+"/"+pod.Name+"-"+volume.Name] = true
+			}
+		}
+	}
+	return mounted, nil
+}
+
+// checkVolumeAttachments, uzun süredir bağlanamayan ya da ayrılamayan
+// VolumeAttachment nesnelerini ilgili node ve pod ile birlikte raporlar.
+// ContainerCreating durumunda takılan pod'ların yaygın bir nedenidir.
+func (s *Suite) checkVolumeAttachments(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	attachments, err := listAll(ctx, s.opts, clientset.StorageV1().VolumeAttachments().List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("VolumeAttachment'ları", err)
+	}
+	var pods *corev1.PodList
+	var pvs map[string]*corev1.PersistentVolume
+	for _, attachment := range attachments.Items {
+		problem := ""
+		if attachment.DeletionTimestamp != nil {
+			if age := time.Since(attachment.DeletionTimestamp.Time); age > s.opts.AttachmentStuckThreshold {
+				problem = fmt.Sprintf("%s süredir ayrılamıyor", age.Round(time.Second))
+			}
+		} else if !attachment.Status.Attached {
+			if age := time.Since(attachment.CreationTimestamp.Time); age > s.opts.AttachmentStuckThreshold {
+				problem = fmt.Sprintf("%s süredir bağlanamıyor", age.Round(time.Second))
+			}
+		}
+		if problem == "" {
+			continue
+		}
+		if attachment.Status.AttachError != nil {
+			problem += ": " + attachment.Status.AttachError.Message
+		}
+		if attachment.Status.DetachError != nil {
+			problem += ": " + attachment.Status.DetachError.Message
+		}
+
+		pvName := ""
+		if attachment.Spec.Source.PersistentVolumeName != nil {
+			pvName = *attachment.Spec.Source.PersistentVolumeName
+		}
+		out.objectf(report.Info, report.ResourceRef{Kind: "VolumeAttachment", Name: attachment.Name}, "AttachmentStuck", "VolumeAttachment %s (PV %s, node %s) %s", attachment.Name, pvName, attachment.Spec.NodeName, problem)
+
+		if pvName == "" {
+			continue
+		}
+		if pvs == nil {
+			list, err := s.listPersistentVolumes(ctx, clientset)
+			if err != nil {
+				return checkerrors.ListFailed("PersistentVolume'leri", err)
+			}
+			pvs = map[string]*corev1.PersistentVolume{}
+			for i := range list.Items {
+				pvs[list.Items[i].Name] = &list.Items[i]
+			}
+		}
+		pv := pvs[pvName]
+		if pv == nil || pv.Spec.ClaimRef == nil {
+			continue
+		}
+		if pods == nil {
+			var err error
+			pods, err = s.listPods(ctx, clientset, "")
+			if err != nil {
+				return checkerrors.ListFailed("Pod'ları", err)
+			}
+		}
+		for _, pod := range pods.Items {
+			if pod.Namespace != pv.Spec.ClaimRef.Namespace {
+				continue
+			}
+			for _, volume := range pod.Spec.Volumes {
+				if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pv.Spec.ClaimRef.Name {
+					out.infof("  Etkilenen pod: %s/%s (node %s, durum %s)", pod.Namespace, pod.Name, pod.Spec.NodeName, pod.Status.Phase)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkHostPathVolumes, izin verilen namespace'ler dışında hostPath volume
+// bağlayan tüm pod'ları yol bilgisiyle raporlar. hostPath hem güvenlik hem de
+// taşınabilirlik açısından riskli olduğu için bu bir denetim listesidir.
+func (s *Suite) checkHostPathVolumes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("hostPath kontrolü için pod'ları", err)
+	}
+	allowed := map[string]bool{}
+	for _, namespace := range splitList(s.opts.HostPathAllowedNamespaces) {
+		allowed[namespace] = true
+	}
+	count := 0
+	for _, pod := range pods.Items {
+		if allowed[pod.Namespace] {
+			continue
+		}
+		var volumes []string
+		for _, volume := range pod.Spec.Volumes {
+			if volume.HostPath == nil {
+				continue
+			}
+			count++
+			volumes = append(volumes, volume.Name+" ("+volume.HostPath.Path+")")
+		}
+		if len(volumes) > 0 {
+			out.objectf(report.Info, podRef(&pod), "HostPathVolume", "Pod %s namespace %s hostPath volume bağlıyor: %s", pod.Name, pod.Namespace, strings.Join(volumes, ", "))
+		}
+	}
+	if count > 0 {
+		out.infof("İzin verilen namespace'ler dışında %d hostPath volume kullanımı var", count)
+	}
+	return nil
+}
+
+// checkEmptyDirVolumes, sizeLimit tanımlamayan emptyDir volume'lerini ve
+// bellek kısıtlı node'larda çalışan bellek tabanlı emptyDir'leri raporlar.
+// Bu volume'ler sıklıkla beklenmedik node baskısına (DiskPressure/MemoryPressure) yol açar.
+func (s *Suite) checkEmptyDirVolumes(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	threshold, err := resource.ParseQuantity(s.opts.MemoryConstrainedNode)
+	if err != nil {
+		return checkerrors.Wrap(checkerrors.CodeInvalidConfig, err, "Geçersiz --memory-constrained-node değeri %q", s.opts.MemoryConstrainedNode)
+	}
+	nodes, err := s.listNodes(ctx, clientset)
+	if err != nil {
+		return checkerrors.ListFailed("emptyDir kontrolü için node'ları", err)
+	}
+	constrained := map[string]bool{}
+	for _, node := range nodes.Items {
+		if memory, ok := node.Status.Allocatable[corev1.ResourceMemory]; ok && memory.Cmp(threshold) < 0 {
+			constrained[node.Name] = true
+		}
+	}
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return checkerrors.ListFailed("emptyDir kontrolü için pod'ları", err)
+	}
+
+	unbounded := 0
+	for _, pod := range pods.Items {
+		var memoryVolumes, unboundedVolumes []string
+		for _, volume := range pod.Spec.Volumes {
+			if volume.EmptyDir == nil {
+				continue
+			}
+			memoryBacked := volume.EmptyDir.Medium == corev1.StorageMediumMemory
+			if memoryBacked && constrained[pod.Spec.NodeName] {
+				memoryVolumes = append(memoryVolumes, volume.Name)
+			}
+			if volume.EmptyDir.SizeLimit == nil {
+				unbounded++
+				unboundedVolumes = append(unboundedVolumes, volume.Name)
+			}
+		}
+		if len(memoryVolumes) > 0 {
+			out.objectf(report.Info, podRef(&pod), "MemoryEmptyDirOnConstrainedNode", "Pod %s namespace %s bellek kısıtlı node %s üzerinde bellek tabanlı emptyDir kullanıyor: %s", pod.Name, pod.Namespace, pod.Spec.NodeName, strings.Join(memoryVolumes, ", "))
+		}
+		if len(unboundedVolumes) > 0 {
+			out.objectf(report.Info, podRef(&pod), "EmptyDirWithoutSizeLimit", "Pod %s namespace %s içinde emptyDir volume'leri sizeLimit tanımlamıyor: %s", pod.Name, pod.Namespace, strings.Join(unboundedVolumes, ", "))
+		}
+	}
+	if unbounded > 0 {
+		out.infof("Cluster'da sizeLimit tanımlamayan %d emptyDir volume var", unbounded)
+	}
+	return nil
+}
+
+// checkStorageQuotas, namespace bazında PVC'lerin istediği toplam kapasiteyi
+// ResourceQuota'lardaki requests.storage (ve StorageClass'a özel) limitleriyle
+// karşılaştırır ve kotasına yaklaşan namespace'leri raporlar.
+func (s *Suite) checkStorageQuotas(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	quotas, err := listAll(ctx, s.opts, clientset.CoreV1().ResourceQuotas("").List, metav1.ListOptions{})
+	if err != nil {
+		return checkerrors.ListFailed("ResourceQuota'ları", err)
+	}
+	if len(quotas.Items) == 0 {
+		return nil
+	}
+	pvcs, err := s.listPersistentVolumeClaims(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Kota kontrolü için PersistentVolumeClaim'leri", err)
+	}
+
+	// requested, namespace başına kaynak adı -> istenen toplam kapasiteyi tutar.
+	requested := map[string]map[corev1.ResourceName]*resource.Quantity{}
+	add := func(namespace string, name corev1.ResourceName, quantity resource.Quantity) {
+		if requested[namespace] == nil {
+			requested[namespace] = map[corev1.ResourceName]*resource.Quantity{}
+		}
+		if requested[namespace][name] == nil {
+			requested[namespace][name] = resource.NewQuantity(0, resource.BinarySI)
+		}
+		requested[namespace][name].Add(quantity)
+	}
+	for _, pvc := range pvcs.Items {
+		size, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if !ok {
+			continue
+		}
+		add(pvc.Namespace, corev1.ResourceRequestsStorage, size)
+		if pvc.Spec.StorageClassName != nil {
+			add(pvc.Namespace, corev1.ResourceName(*pvc.Spec.StorageClassName+".storageclass.storage.k8s.io/requests.storage"), size)
+		}
+	}
+
+	for _, quota := range quotas.Items {
+		names := make([]string, 0, len(quota.Spec.Hard))
+		for name := range quota.Spec.Hard {
+			names = append(names, string(name))
+		}
+		sort.Strings(names)
+		var high []string
+		for _, key := range names {
+			name := corev1.ResourceName(key)
+			hard := quota.Spec.Hard[name]
+			if name != corev1.ResourceRequestsStorage && !strings.HasSuffix(key, ".storageclass.storage.k8s.io/requests.storage") {
+				continue
+			}
+			if hard.IsZero() {
+				continue
+			}
+			used := resource.NewQuantity(0, resource.BinarySI)
+			if q := requested[quota.Namespace][name]; q != nil {
+				used = q
+			}
+			usage := float64(used.Value()) / float64(hard.Value()) * 100
+			if usage >= s.opts.StorageQuotaThreshold {
+				high = append(high, fmt.Sprintf("%s %%%.0f (%s/%s)", name, usage, used.String(), hard.String()))
+			}
+		}
+		if len(high) > 0 {
+			out.objectf(report.Info, report.ResourceRef{Kind: "ResourceQuota", Namespace: quota.Namespace, Name: quota.Name}, "QuotaUsageHigh", "Namespace %s ResourceQuota %s içinde depolama kotalarının büyük kısmı kullanılıyor: %s", quota.Namespace, quota.Name, strings.Join(high, ", "))
+		}
+	}
+	return nil
+}
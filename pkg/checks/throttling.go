@@ -0,0 +1,48 @@
+package checks
+
+import (
+	"context"
+
+	"go-k8s-client/pkg/client"
+	"k8s.io/client-go/kubernetes"
+)
+
+// APIThrottling, son çalışmasından bu yana API server'ın istekleri 429 Too
+// Many Requests ile geri çevirdiği durumları API server baskısı bulgusu
+// olarak raporlar; bunlardan API Priority and Fairness tarafından
+// reddedilenler ayrıca belirtilir. İsteklerin sınıflandırıldığı FlowSchema
+// değiştiğinde adıyla raporlanır ve expectedFlowSchema boş değilse ondan
+// farklı olması uyarı olarak bildirilir. Döngü sırasında gözlenen tüm
+// istekleri kapsaması için diğer kontrollerden sonra çalıştırılmalıdır.
+func APIThrottling(recorder *client.ThrottleRecorder, expectedFlowSchema string) Check {
+	var last client.ThrottleStats
+	return checkFunc{"api-throttling", func(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+		stats := recorder.Stats()
+		throttled := stats.Count - last.Count
+		rejected := stats.APFRejected - last.APFRejected
+		flowSchemaChanged := stats.FlowSchemaUID != last.FlowSchemaUID || stats.PriorityLevelUID != last.PriorityLevelUID
+		last = stats
+		if throttled > 0 {
+			out.warningf("API server son döngüde %d isteği 429 Too Many Requests ile geri çevirdi (son Retry-After: %s); API server yük altında ya da API Priority and Fairness bu aracın isteklerini sınırlıyor", throttled, stats.LastRetryAfter)
+		}
+		if stats.FlowSchemaUID == "" || (!flowSchemaChanged && rejected == 0) {
+			return nil
+		}
+		flowSchema, priorityLevel, err := client.FlowSchemaNames(ctx, clientset, stats.FlowSchemaUID, stats.PriorityLevelUID)
+		if err != nil {
+			out.infof("FlowSchema adları alınamadı: %v", err)
+		}
+		if rejected > 0 {
+			out.warningf("API Priority and Fairness son döngüde bu aracın %d isteğini reddetti (FlowSchema: %s, priority level: %s)", rejected, flowSchema, priorityLevel)
+		}
+		if !flowSchemaChanged {
+			return nil
+		}
+		if expectedFlowSchema != "" && flowSchema != expectedFlowSchema {
+			out.warningf("Bu aracın istekleri beklenen %s yerine %s FlowSchema'sına (priority level: %s) eşleşiyor; izleme istekleri production controller'larıyla aynı kapasiteyi paylaşıyor olabilir", expectedFlowSchema, flowSchema, priorityLevel)
+		} else {
+			out.infof("Bu aracın istekleri %s FlowSchema'sı ile %s priority level'ında sınıflandırılıyor", flowSchema, priorityLevel)
+		}
+		return nil
+	}}
+}
@@ -0,0 +1,109 @@
+package checks
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// trivyScanner, image taramalarını kontrol döngüsünün dışında, arka planda
+// tek tek çalıştırır ve sonuçları ttl süresince saklar. Böylece uzun süren
+// trivy taramaları kontrolün süre bütçesini tüketmez; henüz taranmamış
+// image'ların sonuçları sonraki döngülerde raporlanır.
+type trivyScanner struct {
+	scan    func(ctx context.Context, image string) (int, error)
+	ttl     time.Duration
+	timeout time.Duration
+
+	mu      sync.Mutex
+	results map[string]trivyResult
+	pending []string
+	queued  map[string]bool
+	running bool
+}
+
+// trivyResult, bir image'ın son tarama sonucudur.
+type trivyResult struct {
+	critical int
+	err      error
+	scanned  time.Time
+}
+
+func newTrivyScanner(scan func(ctx context.Context, image string) (int, error), ttl, timeout time.Duration) *trivyScanner {
+	return &trivyScanner{scan: scan, ttl: ttl, timeout: timeout, results: map[string]trivyResult{}, queued: map[string]bool{}}
+}
+
+// lookup, image'ın saklanan sonucunu döndürür. Sonuç yoksa ya da ttl'den
+// eskiyse image taranmak üzere kuyruğa alınır; eskimiş sonuç yeni tarama
+// bitene kadar döndürülmeye devam eder.
+func (t *trivyScanner) lookup(image string) (trivyResult, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	result, ok := t.results[image]
+	if (!ok || time.Since(result.scanned) > t.ttl) && !t.queued[image] {
+		t.queued[image] = true
+		t.pending = append(t.pending, image)
+		if !t.running {
+			t.running = true
+			go t.run()
+		}
+	}
+	return result, ok
+}
+
+// pendingCount, kuyrukta ya da taranmakta olan image sayısını döndürür.
+func (t *trivyScanner) pendingCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.queued)
+}
+
+// run, kuyruk boşalana kadar image'ları sırayla tarar. Taramalar kontrolün
+// context'inden bağımsızdır ve her biri timeout ile sınırlıdır.
+func (t *trivyScanner) run() {
+	for {
+		t.mu.Lock()
+		if len(t.pending) == 0 {
+			t.running = false
+			t.mu.Unlock()
+			return
+		}
+		image := t.pending[0]
+		t.pending = t.pending[1:]
+		t.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), t.timeout)
+		critical, err := t.scan(ctx, image)
+		cancel()
+
+		t.mu.Lock()
+		t.results[image] = trivyResult{critical: critical, err: err, scanned: time.Now()}
+		delete(t.queued, image)
+		t.mu.Unlock()
+	}
+}
+
+// runningImageDigests, pod tanımlarındaki image'ları container durumlarında
+// bildirilen "<repository>@sha256:<digest>" referanslarına eşler.
+func runningImageDigests(pods []corev1.Pod) map[string]string {
+	digests := map[string]string{}
+	for _, pod := range pods {
+		statuses := map[string]string{}
+		for _, status := range append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
+			statuses[status.Name] = status.ImageID
+		}
+		for _, container := range allContainers(&pod.Spec) {
+			imageID := statuses[container.Name]
+			for _, prefix := range []string{"docker-pullable://", "docker://"} {
+				imageID = strings.TrimPrefix(imageID, prefix)
+			}
+			if strings.Contains(imageID, "@sha256:") {
+				digests[container.Image] = imageID
+			}
+		}
+	}
+	return digests
+}
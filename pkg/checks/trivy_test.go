@@ -0,0 +1,76 @@
+package checks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func waitForScans(t *testing.T, scanner *trivyScanner) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for scanner.pendingCount() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("taramalar zamanında bitmedi")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTrivyScannerCachesResults(t *testing.T) {
+	scans := make(chan string, 10)
+	scanner := newTrivyScanner(func(ctx context.Context, image string) (int, error) {
+		scans <- image
+		return 3, nil
+	}, time.Hour, time.Minute)
+
+	if _, ok := scanner.lookup("nginx@sha256:aa"); ok {
+		t.Fatal("taranmamış image için sonuç dönmemeli")
+	}
+	waitForScans(t, scanner)
+	result, ok := scanner.lookup("nginx@sha256:aa")
+	if !ok || result.critical != 3 {
+		t.Fatalf("tarama sonucu saklanmalı, dönen %+v %v", result, ok)
+	}
+	waitForScans(t, scanner)
+	if len(scans) != 1 {
+		t.Errorf("ttl dolmadan image yeniden taranmamalı, tarama sayısı %d", len(scans))
+	}
+}
+
+func TestTrivyScannerRescansAfterTTL(t *testing.T) {
+	scans := make(chan string, 10)
+	scanner := newTrivyScanner(func(ctx context.Context, image string) (int, error) {
+		scans <- image
+		return 0, nil
+	}, 0, time.Minute)
+
+	scanner.lookup("nginx@sha256:aa")
+	waitForScans(t, scanner)
+	if _, ok := scanner.lookup("nginx@sha256:aa"); !ok {
+		t.Fatal("eskimiş sonuç yeniden tarama bitene kadar dönmeli")
+	}
+	waitForScans(t, scanner)
+	if len(scans) != 2 {
+		t.Errorf("ttl dolduktan sonra image yeniden taranmalı, tarama sayısı %d", len(scans))
+	}
+}
+
+func TestRunningImageDigests(t *testing.T) {
+	pod := corev1.Pod{
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "nginx:1.25"}, {Name: "sidecar", Image: "busybox"}}},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "app", ImageID: "docker-pullable://nginx@sha256:aa"},
+			{Name: "sidecar", ImageID: "sha256:bb"},
+		}},
+	}
+	digests := runningImageDigests([]corev1.Pod{pod})
+	if digests["nginx:1.25"] != "nginx@sha256:aa" {
+		t.Errorf("nginx:1.25 digest'i nginx@sha256:aa olmalı, dönen %q", digests["nginx:1.25"])
+	}
+	if _, ok := digests["busybox"]; ok {
+		t.Error("repository içermeyen image ID'leri eşlenmemeli")
+	}
+}
@@ -0,0 +1,89 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	"k8s.io/client-go/kubernetes"
+)
+
+// cachedResult, TTL'li bir kontrolün son başarılı çalışmasının bulgularıdır.
+type cachedResult struct {
+	ranAt    time.Time
+	findings []report.Finding
+}
+
+// cachedCheck, kontrolün bulgularını ttl boyunca Suite'te saklar ve bu süre
+// içindeki döngülerde kontrolü yeniden çalıştırmadan aynı bulguları döndürür.
+type cachedCheck struct {
+	Check
+	ttl   time.Duration
+	suite *Suite
+}
+
+func (c cachedCheck) RequiredAPIGroups() []string {
+	return requiredAPIGroups(c.Check)
+}
+
+func (c cachedCheck) ShardedByNamespace() bool {
+	return IsShardedByNamespace(c.Check)
+}
+
+func (c cachedCheck) Run(ctx context.Context, clientset kubernetes.Interface) ([]report.Finding, error) {
+	c.suite.resultsMu.Lock()
+	cached, ok := c.suite.results[c.Name()]
+	c.suite.resultsMu.Unlock()
+	if age := time.Since(cached.ranAt); ok && age < c.ttl {
+		findings := append([]report.Finding(nil), cached.findings...)
+		return append(findings, report.Finding{Check: c.Name(), Severity: report.Info, Message: fmt.Sprintf("Bulgular %s önce çalıştırılan kontrolden alındı, kontrol %s sonra yeniden çalışacak", age.Round(time.Second), (c.ttl - age).Round(time.Second))}), nil
+	}
+	findings, err := c.Check.Run(ctx, clientset)
+	// Hatalı çalışmalar saklanmaz; kontrol bir sonraki döngüde yeniden denenir.
+	if err == nil {
+		c.suite.resultsMu.Lock()
+		c.suite.results[c.Name()] = cachedResult{ranAt: time.Now(), findings: findings}
+		c.suite.resultsMu.Unlock()
+	}
+	return findings, err
+}
+
+// Cached, --check-ttls içinde süresi verilen kontrolleri, bulgularını bu süre
+// boyunca önbellekten döndürecek şekilde sarar; RBAC denetimi ya da image
+// taraması gibi pahalı kontroller böylece her döngüde çalıştırılmaz. Diğer
+// kontroller olduğu gibi döner. Plugin kontrolleri de adlarıyla
+// ("plugin/check") sarılabilir; checks içinde olmayan bir kontrol adı
+// yapılandırma hatasıdır.
+func (s *Suite) Cached(checks []Check) ([]Check, error) {
+	known := map[string]bool{}
+	for _, check := range checks {
+		known[check.Name()] = true
+	}
+	ttls := map[string]time.Duration{}
+	for _, entry := range splitList(s.opts.CheckTTLs) {
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, checkerrors.New(checkerrors.CodeInvalidConfig, "Geçersiz kontrol TTL'i %q: kontrol=süre biçiminde olmalı", entry)
+		}
+		ttl, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return nil, checkerrors.Wrap(checkerrors.CodeInvalidConfig, err, "Geçersiz kontrol TTL'i %q", entry)
+		}
+		name = strings.TrimSpace(name)
+		if !known[name] {
+			return nil, checkerrors.New(checkerrors.CodeInvalidConfig, "Geçersiz kontrol TTL'i %q: %s adında bir kontrol yok", entry, name)
+		}
+		ttls[name] = ttl
+	}
+	wrapped := make([]Check, 0, len(checks))
+	for _, check := range checks {
+		if ttl, ok := ttls[check.Name()]; ok && ttl > 0 {
+			check = cachedCheck{Check: check, ttl: ttl, suite: s}
+		}
+		wrapped = append(wrapped, check)
+	}
+	return wrapped, nil
+}
@@ -0,0 +1,30 @@
+package checks
+
+import (
+	"testing"
+
+	checkerrors "go-k8s-client/pkg/errors"
+)
+
+func TestCachedRejectsUnknownChecks(t *testing.T) {
+	opts := DefaultOptions()
+	opts.CheckTTLs = "rbac=1h,rbca=1h"
+	s := &Suite{opts: opts}
+	_, err := s.Cached([]Check{checkFunc{"rbac", nil}})
+	if checkerrors.CodeOf(err) != checkerrors.CodeInvalidConfig {
+		t.Fatalf("bilinmeyen kontrol adı CodeInvalidConfig ile reddedilmeli, dönen %v", err)
+	}
+
+	opts.CheckTTLs = "rbac=1h"
+	s = &Suite{opts: opts}
+	checks, err := s.Cached([]Check{checkFunc{"rbac", nil}, checkFunc{"pods", nil}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := checks[0].(cachedCheck); !ok {
+		t.Error("TTL'i verilen kontrol sarılmalı")
+	}
+	if _, ok := checks[1].(cachedCheck); ok {
+		t.Error("TTL'i verilmeyen kontrol olduğu gibi dönmeli")
+	}
+}
@@ -0,0 +1,453 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	checkerrors "go-k8s-client/pkg/errors"
+	"go-k8s-client/pkg/report"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// checkCompletedPods, TTL tanımlanmamış Job'lar gibi kaynaklardan dolayı
+// Succeeded/Failed pod biriktiren namespace'leri raporlar ve istenirse
+// --completed-pod-ttl süresini aşan pod'ları siler.
+func (s *Suite) checkCompletedPods(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Tamamlanmış pod'ları", err)
+	}
+
+	type counts struct{ succeeded, failed int }
+	perNamespace := map[string]*counts{}
+	var expired []corev1.Pod
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		c, ok := perNamespace[pod.Namespace]
+		if !ok {
+			c = &counts{}
+			perNamespace[pod.Namespace] = c
+		}
+		if pod.Status.Phase == corev1.PodSucceeded {
+			c.succeeded++
+		} else {
+			c.failed++
+		}
+		if s.opts.CompletedPodTTL > 0 && time.Since(podFinishedAt(&pod)) > s.opts.CompletedPodTTL {
+			expired = append(expired, pod)
+		}
+	}
+
+	namespaces := make([]string, 0, len(perNamespace))
+	for ns := range perNamespace {
+		namespaces = append(namespaces, ns)
+	}
+	sort.Strings(namespaces)
+	for _, ns := range namespaces {
+		c := perNamespace[ns]
+		if c.succeeded+c.failed >= s.opts.CompletedPodThreshold {
+			out.objectf(report.Info, namespaceRef(ns), "CompletedPodsAccumulated", "Namespace %s içinde %d tamamlanmış pod birikmiş (Succeeded: %d, Failed: %d)", ns, c.succeeded+c.failed, c.succeeded, c.failed)
+		}
+	}
+
+	for _, pod := range expired {
+		err := clientset.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if err != nil {
+			out.infof("Pod %s namespace %s içinde silinirken hata oluştu: %v", pod.Name, pod.Namespace, err)
+			continue
+		}
+		out.infof("Pod %s namespace %s içinde TTL süresi dolduğu için silindi", pod.Name, pod.Namespace)
+	}
+	return nil
+}
+
+// podFinishedAt, pod'daki container'ların en son sonlandığı zamanı döndürür.
+// Sonlanma zamanı bulunamazsa pod'un oluşturulma zamanı kullanılır.
+func podFinishedAt(pod *corev1.Pod) time.Time {
+	finished := pod.CreationTimestamp.Time
+	for _, status := range pod.Status.ContainerStatuses {
+		if t := status.State.Terminated; t != nil && t.FinishedAt.After(finished) {
+			finished = t.FinishedAt.Time
+		}
+	}
+	return finished
+}
+
+// checkImageDrift, aynı iş yüküne ait pod'ların farklı image'ları
+// --rollout-window süresinden uzun bir süre birlikte çalıştırdığı durumları
+// raporlar. Bu durum genellikle takılmış ya da yarım kalmış bir rollout'a işaret eder.
+func (s *Suite) checkImageDrift(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("Image sapması için pod'ları", err)
+	}
+	replicaSets, err := s.listReplicaSets(ctx, clientset, "")
+	if err != nil {
+		return checkerrors.ListFailed("ReplicaSet'leri", err)
+	}
+	rsOwners := replicaSetOwners(replicaSets.Items)
+
+	type workloadImages struct {
+		images map[string]map[string]bool
+		newest time.Time
+	}
+	workloads := map[string]*workloadImages{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		key := podWorkload(&pod, rsOwners)
+		if key == "" {
+			continue
+		}
+		w, ok := workloads[key]
+		if !ok {
+			w = &workloadImages{images: map[string]map[string]bool{}}
+			workloads[key] = w
+		}
+		if pod.CreationTimestamp.After(w.newest) {
+			w.newest = pod.CreationTimestamp.Time
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			image := status.ImageID
+			if image == "" {
+				image = status.Image
+			}
+			if w.images[status.Name] == nil {
+				w.images[status.Name] = map[string]bool{}
+			}
+			w.images[status.Name][image] = true
+		}
+	}
+
+	keys := make([]string, 0, len(workloads))
+	for key := range workloads {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		w := workloads[key]
+		if time.Since(w.newest) < s.opts.RolloutWindow {
+			continue
+		}
+		containers := make([]string, 0, len(w.images))
+		for container := range w.images {
+			containers = append(containers, container)
+		}
+		sort.Strings(containers)
+		for _, container := range containers {
+			if images := w.images[container]; len(images) > 1 {
+				out.infof("%s içindeki %s container'ı %s süredir %d farklı image ile çalışıyor", key, container, time.Since(w.newest).Round(time.Second), len(images))
+			}
+		}
+	}
+	return nil
+}
+
+// replicaSetOwners, namespace/ReplicaSet adını sahibi olan Deployment adına eşler.
+func replicaSetOwners(replicaSets []appsv1.ReplicaSet) map[string]string {
+	owners := map[string]string{}
+	for _, rs := range replicaSets {
+		if owner := metav1.GetControllerOf(&rs); owner != nil && owner.Kind == "Deployment" {
+			owners[rs.Namespace+"/"+rs.Name] = owner.Name
+		}
+	}
+	return owners
+}
+
+// podWorkload, pod'u yöneten iş yükünü "Tür namespace/ad" biçiminde döndürür.
+// ReplicaSet'ler sahibi olan Deployment'a çözülür; controller'ı olmayan pod'lar için boş döner.
+func podWorkload(pod *corev1.Pod, rsOwners map[string]string) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return ""
+	}
+	if owner.Kind == "ReplicaSet" {
+		if deployment, ok := rsOwners[pod.Namespace+"/"+owner.Name]; ok {
+			return fmt.Sprintf("Deployment %s/%s", pod.Namespace, deployment)
+		}
+	}
+	return fmt.Sprintf("%s %s/%s", owner.Kind, pod.Namespace, owner.Name)
+}
+
+// webhookNamePattern, admission webhook hata mesajlarındaki webhook adını yakalar.
+var webhookNamePattern = regexp.MustCompile(`webhook "([^"]+)"`)
+
+// checkWebhookBlockedRollouts, ReplicaSet ve Job'lar üzerindeki FailedCreate
+// event'lerinden admission webhook kaynaklı olanları bulur ve yeni pod'ların
+// oluşturulmasını engelleyen webhook yapılandırmasını raporlar.
+func (s *Suite) checkWebhookBlockedRollouts(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	events, err := s.listEvents(ctx, clientset, "reason=FailedCreate", "FailedCreate")
+	if err != nil {
+		return checkerrors.ListFailed("FailedCreate event'lerini", err)
+	}
+
+	var configurations map[string]string
+	for _, event := range events {
+		kind := event.InvolvedObject.Kind
+		if kind != "ReplicaSet" && kind != "Job" {
+			continue
+		}
+		match := webhookNamePattern.FindStringSubmatch(event.Message)
+		if match == nil {
+			continue
+		}
+		if configurations == nil {
+			configurations = s.webhookConfigurations(ctx, clientset, out)
+		}
+		configuration, ok := configurations[match[1]]
+		if !ok {
+			configuration = "bilinmeyen yapılandırma"
+		}
+		out.infof("%s %s/%s pod oluşturamıyor: webhook %s (%s) engelliyor (%d kez): %s", kind, event.Namespace, event.InvolvedObject.Name, match[1], configuration, event.Count, event.Message)
+	}
+	return nil
+}
+
+// webhookConfigurations, webhook adlarını ait oldukları
+// Validating/MutatingWebhookConfiguration nesnelerine eşler.
+func (s *Suite) webhookConfigurations(ctx context.Context, clientset kubernetes.Interface, out *findings) map[string]string {
+	configurations := map[string]string{}
+	validating, err := listAll(ctx, s.opts, clientset.AdmissionregistrationV1().ValidatingWebhookConfigurations().List, metav1.ListOptions{})
+	if err != nil {
+		out.infof("ValidatingWebhookConfiguration'ları listelerken hata oluştu: %v", err)
+	} else {
+		for _, configuration := range validating.Items {
+			for _, webhook := range configuration.Webhooks {
+				configurations[webhook.Name] = "ValidatingWebhookConfiguration " + configuration.Name
+			}
+		}
+	}
+	mutating, err := listAll(ctx, s.opts, clientset.AdmissionregistrationV1().MutatingWebhookConfigurations().List, metav1.ListOptions{})
+	if err != nil {
+		out.infof("MutatingWebhookConfiguration'ları listelerken hata oluştu: %v", err)
+	} else {
+		for _, configuration := range mutating.Items {
+			for _, webhook := range configuration.Webhooks {
+				configurations[webhook.Name] = "MutatingWebhookConfiguration " + configuration.Name
+			}
+		}
+	}
+	return configurations
+}
+
+// checkEphemeralStorage, kubelet özet API'si üzerinden pod'ların
+// ephemeral-storage kullanımını okur ve evict edilmeden önce limitlerine
+// yaklaşan pod'ları raporlar.
+func (s *Suite) checkEphemeralStorage(ctx context.Context, clientset kubernetes.Interface, out *findings) error {
+	pods, err := listAll(ctx, s.opts, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return checkerrors.ListFailed("Ephemeral-storage için pod'ları", err)
+	}
+	limits := map[string]int64{}
+	nodes := map[string]bool{}
+	for _, pod := range pods.Items {
+		var limit int64
+		for _, container := range pod.Spec.Containers {
+			quantity, ok := container.Resources.Limits[corev1.ResourceEphemeralStorage]
+			if !ok {
+				limit = 0
+				break
+			}
+			limit += quantity.Value()
+		}
+		if limit > 0 {
+			limits[pod.Namespace+"/"+pod.Name] = limit
+			nodes[pod.Spec.NodeName] = true
+		}
+	}
+
+	nodeNames := make([]string, 0, len(nodes))
+	for nodeName := range nodes {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+	for _, nodeName := range nodeNames {
+		summary, err := getKubeletSummary(ctx, clientset, nodeName)
+		if err != nil {
+			out.infof("Node %s için kubelet istatistikleri alınırken hata oluştu: %v", nodeName, err)
+			continue
+		}
+		for _, podStats := range summary.Pods {
+			limit, ok := limits[podStats.PodRef.Namespace+"/"+podStats.PodRef.Name]
+			if !ok || podStats.EphemeralStorage == nil || podStats.EphemeralStorage.UsedBytes == nil {
+				continue
+			}
+			usage := float64(*podStats.EphemeralStorage.UsedBytes) / float64(limit) * 100
+			if usage >= s.opts.EphemeralStorageThreshold {
+				out.objectf(report.Warning, report.ResourceRef{Kind: "Pod", Namespace: podStats.PodRef.Namespace, Name: podStats.PodRef.Name}, "EphemeralStorageUsageHigh", "Pod %s namespace %s içinde ephemeral-storage limitinin %%%.0f kadarını kullanıyor", podStats.PodRef.Name, podStats.PodRef.Namespace, usage)
+			}
+		}
+	}
+	return nil
+}
+
+// podSpecSource, bir pod tanımını ve onu içeren nesneyi tanımlar.
+type podSpecSource struct {
+	kind        string
+	namespace   string
+	name        string
+	annotations map[string]string
+	spec        *corev1.PodSpec
+}
+
+func (s podSpecSource) String() string {
+	return fmt.Sprintf("%s %s/%s", s.kind, s.namespace, s.name)
+}
+
+func (s podSpecSource) ref() report.ResourceRef {
+	return report.ResourceRef{Kind: s.kind, Namespace: s.namespace, Name: s.name}
+}
+
+// listPodSpecs, controller'ı olmayan pod'ların ve Deployment, StatefulSet,
+// DaemonSet, Job ve CronJob şablonlarının pod tanımlarını döndürür. Bir
+// controller'a ait pod'lar ve Job'lar, şablonları zaten listelendiği için atlanır.
+func (s *Suite) listPodSpecs(ctx context.Context, clientset kubernetes.Interface) ([]podSpecSource, error) {
+	var sources []podSpecSource
+	pods, err := s.listPods(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if metav1.GetControllerOf(pod) == nil {
+			sources = append(sources, podSpecSource{"Pod", pod.Namespace, pod.Name, pod.Annotations, &pod.Spec})
+		}
+	}
+	deployments, err := s.listDeployments(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		sources = append(sources, podSpecSource{"Deployment", d.Namespace, d.Name, d.Annotations, &d.Spec.Template.Spec})
+	}
+	statefulSets, err := s.listStatefulSets(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range statefulSets.Items {
+		s := &statefulSets.Items[i]
+		sources = append(sources, podSpecSource{"StatefulSet", s.Namespace, s.Name, s.Annotations, &s.Spec.Template.Spec})
+	}
+	daemonSets, err := s.listDaemonSets(ctx, clientset, "")
+	if err != nil {
+		return nil, err
+	}
+	for i := range daemonSets.Items {
+		d := &daemonSets.Items[i]
+		sources = append(sources, podSpecSource{"DaemonSet", d.Namespace, d.Name, d.Annotations, &d.Spec.Template.Spec})
+	}
+	jobs, err := listAll(ctx, s.opts, clientset.BatchV1().Jobs("").List, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range jobs.Items {
+		j := &jobs.Items[i]
+		if metav1.GetControllerOf(j) == nil {
+			sources = append(sources, podSpecSource{"Job", j.Namespace, j.Name, j.Annotations, &j.Spec.Template.Spec})
+		}
+	}
+	cronJobs, err := listAll(ctx, s.opts, clientset.BatchV1().CronJobs("").List, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range cronJobs.Items {
+		c := &cronJobs.Items[i]
+		sources = append(sources, podSpecSource{"CronJob", c.Namespace, c.Name, c.Annotations, &c.Spec.JobTemplate.Spec.Template.Spec})
+	}
+	return sources, nil
+}
+
+// objectReference, bir pod tanımından Secret ya da ConfigMap'e yapılan bir referanstır.
+type objectReference struct {
+	name     string
+	usage    string
+	optional bool
+}
+
+// podSpecSecretReferences, pod tanımındaki envFrom, env.valueFrom, volume,
+// projected volume ve imagePullSecrets Secret referanslarını döndürür.
+func podSpecSecretReferences(spec *corev1.PodSpec) []objectReference {
+	var refs []objectReference
+	for _, secret := range spec.ImagePullSecrets {
+		refs = append(refs, objectReference{secret.Name, "imagePullSecrets", false})
+	}
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil {
+			refs = append(refs, objectReference{volume.Secret.SecretName, "volume " + volume.Name, isOptional(volume.Secret.Optional)})
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					refs = append(refs, objectReference{source.Secret.Name, "projected volume " + volume.Name, isOptional(source.Secret.Optional)})
+				}
+			}
+		}
+	}
+	for _, container := range allContainers(spec) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil {
+				refs = append(refs, objectReference{envFrom.SecretRef.Name, "container " + container.Name + " envFrom", isOptional(envFrom.SecretRef.Optional)})
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+				refs = append(refs, objectReference{env.ValueFrom.SecretKeyRef.Name, "container " + container.Name + " env " + env.Name, isOptional(env.ValueFrom.SecretKeyRef.Optional)})
+			}
+		}
+	}
+	return refs
+}
+
+// allContainers, pod tanımındaki init, normal ve ephemeral container'ları döndürür.
+func allContainers(spec *corev1.PodSpec) []corev1.Container {
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, ephemeral := range spec.EphemeralContainers {
+		containers = append(containers, corev1.Container(ephemeral.EphemeralContainerCommon))
+	}
+	return containers
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
+
+// podSpecConfigMapReferences, pod tanımındaki envFrom, env.valueFrom, volume ve
+// projected volume ConfigMap referanslarını döndürür.
+func podSpecConfigMapReferences(spec *corev1.PodSpec) []objectReference {
+	var refs []objectReference
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			refs = append(refs, objectReference{volume.ConfigMap.Name, "volume " + volume.Name, isOptional(volume.ConfigMap.Optional)})
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil {
+					refs = append(refs, objectReference{source.ConfigMap.Name, "projected volume " + volume.Name, isOptional(source.ConfigMap.Optional)})
+				}
+			}
+		}
+	}
+	for _, container := range allContainers(spec) {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				refs = append(refs, objectReference{envFrom.ConfigMapRef.Name, "container " + container.Name + " envFrom", isOptional(envFrom.ConfigMapRef.Optional)})
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil {
+				refs = append(refs, objectReference{env.ValueFrom.ConfigMapKeyRef.Name, "container " + container.Name + " env " + env.Name, isOptional(env.ValueFrom.ConfigMapKeyRef.Optional)})
+			}
+		}
+	}
+	return refs
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// FlowSchemaConfig, bu aracın isteklerini düşük öncelikli bir API Priority
+// and Fairness seviyesine yönlendiren FlowSchema'yı tanımlar.
+type FlowSchemaConfig struct {
+	// Name, oluşturulacak FlowSchema'nın adıdır.
+	Name string
+	// PriorityLevel, isteklerin yönlendirileceği mevcut
+	// PriorityLevelConfiguration'dır (ör. workload-low).
+	PriorityLevel string
+	// MatchingPrecedence, FlowSchema'nın önceliğidir; küçük değerler önce
+	// eşleşir. Yerleşik service-accounts FlowSchema'sından (9000) küçük
+	// olmalıdır, aksi halde istekler ona eşleşir.
+	MatchingPrecedence int32
+	// ServiceAccountNamespace ve ServiceAccountName, aracın çalıştığı
+	// ServiceAccount'tur; FlowSchema yalnızca bu kimliğin isteklerine eşleşir.
+	ServiceAccountNamespace string
+	ServiceAccountName      string
+}
+
+// flowcontrolV1Served, flowcontrol.apiserver.k8s.io/v1'in (Kubernetes 1.29 ve
+// sonrası) sunulup sunulmadığını döndürür. v1beta3 Kubernetes 1.32'de
+// kaldırıldığından v1 sunuluyorsa her zaman o kullanılır.
+func flowcontrolV1Served(clientset kubernetes.Interface) (bool, error) {
+	resources, err := clientset.Discovery().ServerResourcesForGroupVersion(flowcontrolv1.SchemeGroupVersion.String())
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("%s API sürümü alınırken hata oluştu: %w", flowcontrolv1.SchemeGroupVersion, err)
+	}
+	for _, resource := range resources.APIResources {
+		if resource.Name == "flowschemas" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// EnsureFlowSchema, config'deki FlowSchema'yı oluşturur ya da spec'i
+// farklıysa günceller. FlowSchema'lar cluster genelinde olduğundan
+// flowcontrol.apiserver.k8s.io flowschemas için create/update yetkisi gerekir.
+// flowcontrol/v1 sunulmuyorsa (Kubernetes 1.29 öncesi) v1beta3 kullanılır.
+func EnsureFlowSchema(ctx context.Context, clientset kubernetes.Interface, config FlowSchemaConfig) error {
+	v1, err := flowcontrolV1Served(clientset)
+	if err != nil {
+		return err
+	}
+	spec := flowcontrolv1.FlowSchemaSpec{
+		PriorityLevelConfiguration: flowcontrolv1.PriorityLevelConfigurationReference{Name: config.PriorityLevel},
+		MatchingPrecedence:         config.MatchingPrecedence,
+		DistinguisherMethod:        &flowcontrolv1.FlowDistinguisherMethod{Type: flowcontrolv1.FlowDistinguisherMethodByUserType},
+		Rules: []flowcontrolv1.PolicyRulesWithSubjects{{
+			Subjects: []flowcontrolv1.Subject{{
+				Kind: flowcontrolv1.SubjectKindServiceAccount,
+				ServiceAccount: &flowcontrolv1.ServiceAccountSubject{
+					Namespace: config.ServiceAccountNamespace,
+					Name:      config.ServiceAccountName,
+				},
+			}},
+			ResourceRules: []flowcontrolv1.ResourcePolicyRule{{
+				Verbs:        []string{flowcontrolv1.VerbAll},
+				APIGroups:    []string{flowcontrolv1.APIGroupAll},
+				Resources:    []string{flowcontrolv1.ResourceAll},
+				ClusterScope: true,
+				Namespaces:   []string{flowcontrolv1.NamespaceEvery},
+			}},
+			NonResourceRules: []flowcontrolv1.NonResourcePolicyRule{{
+				Verbs:           []string{flowcontrolv1.VerbAll},
+				NonResourceURLs: []string{flowcontrolv1.NonResourceAll},
+			}},
+		}},
+	}
+	if !v1 {
+		return ensureFlowSchemaV1beta3(ctx, clientset, config, spec)
+	}
+
+	flowcontrol := clientset.FlowcontrolV1()
+	if _, err := flowcontrol.PriorityLevelConfigurations().Get(ctx, config.PriorityLevel, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("PriorityLevelConfiguration %s alınırken hata oluştu: %w", config.PriorityLevel, err)
+	}
+	existing, err := flowcontrol.FlowSchemas().Get(ctx, config.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		flowSchema := &flowcontrolv1.FlowSchema{ObjectMeta: metav1.ObjectMeta{Name: config.Name}, Spec: spec}
+		if _, err := flowcontrol.FlowSchemas().Create(ctx, flowSchema, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("FlowSchema %s oluşturulurken hata oluştu: %w", config.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("FlowSchema %s alınırken hata oluştu: %w", config.Name, err)
+	}
+	if reflect.DeepEqual(existing.Spec, spec) {
+		return nil
+	}
+	existing.Spec = spec
+	if _, err := flowcontrol.FlowSchemas().Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("FlowSchema %s güncellenirken hata oluştu: %w", config.Name, err)
+	}
+	return nil
+}
+
+// ensureFlowSchemaV1beta3, EnsureFlowSchema'nın flowcontrol/v1 sunmayan
+// cluster'lar için v1beta3 ile çalışan karşılığıdır. FlowSchemaSpec'in iki
+// sürümdeki JSON şeması aynı olduğundan spec JSON üzerinden çevrilir.
+func ensureFlowSchemaV1beta3(ctx context.Context, clientset kubernetes.Interface, config FlowSchemaConfig, v1Spec flowcontrolv1.FlowSchemaSpec) error {
+	var spec flowcontrolv1beta3.FlowSchemaSpec
+	data, err := json.Marshal(v1Spec)
+	if err == nil {
+		err = json.Unmarshal(data, &spec)
+	}
+	if err != nil {
+		return fmt.Errorf("FlowSchema %s v1beta3'e çevrilemedi: %w", config.Name, err)
+	}
+
+	flowcontrol := clientset.FlowcontrolV1beta3()
+	if _, err := flowcontrol.PriorityLevelConfigurations().Get(ctx, config.PriorityLevel, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("PriorityLevelConfiguration %s alınırken hata oluştu: %w", config.PriorityLevel, err)
+	}
+	existing, err := flowcontrol.FlowSchemas().Get(ctx, config.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		flowSchema := &flowcontrolv1beta3.FlowSchema{ObjectMeta: metav1.ObjectMeta{Name: config.Name}, Spec: spec}
+		if _, err := flowcontrol.FlowSchemas().Create(ctx, flowSchema, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("FlowSchema %s oluşturulurken hata oluştu: %w", config.Name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("FlowSchema %s alınırken hata oluştu: %w", config.Name, err)
+	}
+	if reflect.DeepEqual(existing.Spec, spec) {
+		return nil
+	}
+	existing.Spec = spec
+	if _, err := flowcontrol.FlowSchemas().Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("FlowSchema %s güncellenirken hata oluştu: %w", config.Name, err)
+	}
+	return nil
+}
+
+// FlowSchemaNames, UID'leri verilen FlowSchema ve PriorityLevelConfiguration'ın
+// adlarını döndürür; bulunamayanlar için UID'nin kendisi döner.
+func FlowSchemaNames(ctx context.Context, clientset kubernetes.Interface, flowSchemaUID, priorityLevelUID string) (flowSchema, priorityLevel string, err error) {
+	flowSchema, priorityLevel = flowSchemaUID, priorityLevelUID
+	v1, err := flowcontrolV1Served(clientset)
+	if err != nil {
+		return flowSchema, priorityLevel, err
+	}
+	var flowSchemaObjects, priorityLevelObjects []metav1.ObjectMeta
+	if v1 {
+		flowSchemas, err := clientset.FlowcontrolV1().FlowSchemas().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return flowSchema, priorityLevel, fmt.Errorf("FlowSchema'ları listelerken hata oluştu: %w", err)
+		}
+		for _, item := range flowSchemas.Items {
+			flowSchemaObjects = append(flowSchemaObjects, item.ObjectMeta)
+		}
+		priorityLevels, err := clientset.FlowcontrolV1().PriorityLevelConfigurations().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return flowSchema, priorityLevel, fmt.Errorf("PriorityLevelConfiguration'ları listelerken hata oluştu: %w", err)
+		}
+		for _, item := range priorityLevels.Items {
+			priorityLevelObjects = append(priorityLevelObjects, item.ObjectMeta)
+		}
+	} else {
+		flowSchemas, err := clientset.FlowcontrolV1beta3().FlowSchemas().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return flowSchema, priorityLevel, fmt.Errorf("FlowSchema'ları listelerken hata oluştu: %w", err)
+		}
+		for _, item := range flowSchemas.Items {
+			flowSchemaObjects = append(flowSchemaObjects, item.ObjectMeta)
+		}
+		priorityLevels, err := clientset.FlowcontrolV1beta3().PriorityLevelConfigurations().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return flowSchema, priorityLevel, fmt.Errorf("PriorityLevelConfiguration'ları listelerken hata oluştu: %w", err)
+		}
+		for _, item := range priorityLevels.Items {
+			priorityLevelObjects = append(priorityLevelObjects, item.ObjectMeta)
+		}
+	}
+	for _, item := range flowSchemaObjects {
+		if string(item.UID) == flowSchemaUID {
+			flowSchema = item.Name
+		}
+	}
+	for _, item := range priorityLevelObjects {
+		if string(item.UID) == priorityLevelUID {
+			priorityLevel = item.Name
+		}
+	}
+	return flowSchema, priorityLevel, nil
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var testFlowSchemaConfig = FlowSchemaConfig{
+	Name:                    "go-k8s-client",
+	PriorityLevel:           "workload-low",
+	MatchingPrecedence:      1000,
+	ServiceAccountNamespace: "monitoring",
+	ServiceAccountName:      "go-k8s-client",
+}
+
+func TestEnsureFlowSchemaUsesV1WhenServed(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&flowcontrolv1.PriorityLevelConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "workload-low"}})
+	clientset.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: flowcontrolv1.SchemeGroupVersion.String(),
+		APIResources: []metav1.APIResource{{Name: "flowschemas"}, {Name: "prioritylevelconfigurations"}},
+	}}
+	if err := EnsureFlowSchema(context.Background(), clientset, testFlowSchemaConfig); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := clientset.FlowcontrolV1().FlowSchemas().Get(context.Background(), "go-k8s-client", metav1.GetOptions{}); err != nil {
+		t.Errorf("FlowSchema v1 ile oluşturulmalı: %v", err)
+	}
+}
+
+func TestEnsureFlowSchemaFallsBackToV1beta3(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&flowcontrolv1beta3.PriorityLevelConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "workload-low"}})
+	if err := EnsureFlowSchema(context.Background(), clientset, testFlowSchemaConfig); err != nil {
+		t.Fatal(err)
+	}
+	flowSchema, err := clientset.FlowcontrolV1beta3().FlowSchemas().Get(context.Background(), "go-k8s-client", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("v1 sunulmadığında FlowSchema v1beta3 ile oluşturulmalı: %v", err)
+	}
+	if flowSchema.Spec.PriorityLevelConfiguration.Name != "workload-low" || len(flowSchema.Spec.Rules) != 1 {
+		t.Errorf("v1beta3 spec'i v1 spec'inden çevrilmeli: %+v", flowSchema.Spec)
+	}
+}
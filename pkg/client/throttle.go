@@ -0,0 +1,91 @@
+package client
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ThrottleStats, başlangıçtan beri gözlenen sunucu tarafı sınırlamalarını özetler.
+type ThrottleStats struct {
+	// Count, API server'ın 429 Too Many Requests ile geri çevirdiği istek sayısıdır.
+	Count int
+	// LastRetryAfter, son 429 yanıtındaki Retry-After süresidir.
+	LastRetryAfter time.Duration
+	// APFRejected, 429 yanıtlarından API Priority and Fairness tarafından
+	// reddedilenlerin (yanıtta X-Kubernetes-PF-* başlıkları olanların) sayısıdır.
+	APFRejected int
+	// FlowSchemaUID ve PriorityLevelUID, API server'ın son yanıtta bu aracın
+	// isteklerini sınıflandırdığı FlowSchema ve PriorityLevelConfiguration'ın
+	// UID'leridir. APF kapalıysa boş kalırlar.
+	FlowSchemaUID    string
+	PriorityLevelUID string
+}
+
+// ThrottleRecorder, API server'ın 429 yanıtlarını ve isteklerin eşleştiği
+// APF FlowSchema'sını transport seviyesinde kaydeder. client-go bu yanıtları Retry-After kadar bekleyip kendisi yeniden
+// denediği için kontroller çoğu zaman hata görmez; hem API Priority and
+// Fairness reddi hem de storage hazır olmadığında dönen 429'lar ancak burada
+// görünür olur.
+type ThrottleRecorder struct {
+	mu    sync.Mutex
+	stats ThrottleStats
+}
+
+// Stats, şimdiye kadar kaydedilen sınırlamaları döndürür.
+func (r *ThrottleRecorder) Stats() ThrottleStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+// APF yanıt başlıkları; API server her yanıtta isteğin eşleştiği FlowSchema
+// ve priority level'ı bildirir.
+const (
+	flowSchemaUIDHeader    = "X-Kubernetes-PF-FlowSchema-UID"
+	priorityLevelUIDHeader = "X-Kubernetes-PF-PriorityLevel-UID"
+)
+
+func (r *ThrottleRecorder) record(response *http.Response) {
+	flowSchema := response.Header.Get(flowSchemaUIDHeader)
+	priorityLevel := response.Header.Get(priorityLevelUIDHeader)
+	throttled := response.StatusCode == http.StatusTooManyRequests
+	if !throttled && flowSchema == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if flowSchema != "" {
+		r.stats.FlowSchemaUID = flowSchema
+		r.stats.PriorityLevelUID = priorityLevel
+	}
+	if !throttled {
+		return
+	}
+	r.stats.Count++
+	if flowSchema != "" {
+		r.stats.APFRejected++
+	}
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+		r.stats.LastRetryAfter = time.Duration(seconds) * time.Second
+	}
+}
+
+// wrap, rest.Config.Wrap ile istemcilerin transport'una eklenir.
+func (r *ThrottleRecorder) wrap(next http.RoundTripper) http.RoundTripper {
+	return throttleRoundTripper{next: next, recorder: r}
+}
+
+type throttleRoundTripper struct {
+	next     http.RoundTripper
+	recorder *ThrottleRecorder
+}
+
+func (t throttleRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := t.next.RoundTrip(request)
+	if err == nil {
+		t.recorder.record(response)
+	}
+	return response, err
+}
@@ -0,0 +1,130 @@
+// Package errors, kontrollerin döndürdüğü hataları kodlarıyla ve sarılmış
+// nedenleriyle tanımlar. Hatalar standart errors.Is ve errors.As ile
+// incelenebilir:
+//
+//	var notInStatus errors.PersistentVolumeClaimNotInStatus
+//	if stderrors.As(err, &notInStatus) { ... }
+//	if errors.CodeOf(err) == errors.CodeListFailed { ... }
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Code, hatanın türünü makine tarafından okunabilir şekilde belirtir.
+type Code string
+
+const (
+	// CodeNoNodes, cluster'da hiç node olmadığını belirtir.
+	CodeNoNodes Code = "NoNodes"
+	// CodePersistentVolumeClaimNotInStatus, bir PVC'nin beklenen fazda olmadığını belirtir.
+	CodePersistentVolumeClaimNotInStatus Code = "PersistentVolumeClaimNotInStatus"
+	// CodeListFailed, bir resource listelenemediğinde kullanılır.
+	CodeListFailed Code = "ListFailed"
+	// CodeRequestFailed, listeleme dışındaki bir API ya da ağ isteği başarısız olduğunda kullanılır.
+	CodeRequestFailed Code = "RequestFailed"
+	// CodeInvalidConfig, bir flag ya da yapılandırma değeri geçersiz olduğunda kullanılır.
+	CodeInvalidConfig Code = "InvalidConfig"
+	// CodeTimeout, kontrol kendi süre sınırı içinde tamamlanmadığında kullanılır.
+	CodeTimeout Code = "Timeout"
+	// CodeCanceled, kontrol döngü iptal edildiği için çalıştırılmadığında kullanılır.
+	CodeCanceled Code = "Canceled"
+)
+
+// coder, kodu olan hataların uyguladığı arayüzdür.
+type coder interface {
+	Code() Code
+}
+
+// CodeOf, err zincirindeki ilk kodlu hatanın kodunu döndürür; kodlu hata
+// yoksa boş döner.
+func CodeOf(err error) Code {
+	var c coder
+	if stderrors.As(err, &c) {
+		return c.Code()
+	}
+	return ""
+}
+
+// Error, kodu, mesajı ve sarılmış nedeni olan bir kontrol hatasıdır.
+type Error struct {
+	code    Code
+	message string
+	cause   error
+}
+
+// Wrap, cause'u verilen kod ve biçimlendirilmiş mesajla sarar. Hata mesajı
+// "<mesaj>: <neden>" biçimindedir.
+func Wrap(code Code, cause error, format string, args ...interface{}) error {
+	return &Error{code: code, message: fmt.Sprintf(format, args...), cause: cause}
+}
+
+// New, nedeni olmayan, verilen kod ve biçimlendirilmiş mesajla bir hata
+// döndürür.
+func New(code Code, format string, args ...interface{}) error {
+	return Wrap(code, nil, format, args...)
+}
+
+// ListFailed, what ile adlandırılan resource'un listelenemediğini belirten
+// hatayı döndürür (ör. ListFailed("Node'ları", err)).
+func ListFailed(what string, cause error) error {
+	return Wrap(CodeListFailed, cause, "%s listelerken hata oluştu", what)
+}
+
+func (e *Error) Error() string {
+	if e.cause == nil {
+		return e.message
+	}
+	return e.message + ": " + e.cause.Error()
+}
+
+func (e *Error) Code() Code {
+	return e.code
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is, aynı koda sahip Error'ları eşit sayar; böylece errors.Is(err,
+// errors.Sentinel(errors.CodeListFailed)) ile kod karşılaştırılabilir.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.message == "" && t.cause == nil && t.code == e.code
+}
+
+// Sentinel, errors.Is ile yalnızca kod karşılaştırması yapmak için kullanılan
+// mesajsız bir hata döndürür.
+func Sentinel(code Code) error {
+	return &Error{code: code}
+}
+
+// NoNodesInKubernetes, Kubernetes cluster'ında hiç node olmadığında döndürülür.
+type NoNodesInKubernetes struct{}
+
+func (err NoNodesInKubernetes) Error() string {
+	return "Kubernetes cluster'ında hiç node yok"
+}
+
+func (err NoNodesInKubernetes) Code() Code {
+	return CodeNoNodes
+}
+
+// PersistentVolumeClaimNotInStatus, bir PersistentVolumeClaim beklenen durumda olmadığında döndürülür.
+type PersistentVolumeClaimNotInStatus struct {
+	Namespace string
+	Name      string
+	Phase     corev1.PersistentVolumeClaimPhase
+	Expected  corev1.PersistentVolumeClaimPhase
+}
+
+func (err PersistentVolumeClaimNotInStatus) Error() string {
+	return fmt.Sprintf("PersistentVolumeClaim %s beklenen %v durumunda değil", err.Name, err.Expected)
+}
+
+func (err PersistentVolumeClaimNotInStatus) Code() Code {
+	return CodePersistentVolumeClaimNotInStatus
+}
@@ -0,0 +1,101 @@
+// Package leader, birden fazla replica ile çalıştırıldığında kontrolleri
+// aynı anda yalnızca bir instance'ın çalıştırması için Lease tabanlı leader
+// election sağlar.
+package leader
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+// Config, liderlik için kullanılan Lease'i ve leader election sürelerini belirler.
+type Config struct {
+	Namespace     string
+	Name          string
+	Identity      string
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// Run, Lease'i alana kadar bekler ve lider olduğunda run'ı çalıştırır. run
+// döndüğünde Lease bırakılır ve Run döner; böylece bekleyen replica'lardan
+// biri hemen devralabilir. Liderlik kaybedilirse run'a verilen context iptal
+// edilir ve instance yeniden aday olur. stop kapandığında lider olmayan
+// instance beklemeyi bırakır; lider olan instance ise run'ın dönmesini
+// bekler. ctx iptali her durumda election'ı sonlandırır.
+func Run(ctx context.Context, stop <-chan struct{}, clientset kubernetes.Interface, config Config, run func(ctx context.Context)) error {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Namespace: config.Namespace, Name: config.Name},
+		Client:     clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: config.Identity},
+	}
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		electionCtx, cancel := context.WithCancel(ctx)
+		var leading, finished atomic.Bool
+		go func() {
+			select {
+			case <-stop:
+				if !leading.Load() {
+					cancel()
+				}
+			case <-electionCtx.Done():
+			}
+		}()
+		elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   config.LeaseDuration,
+			RenewDeadline:   config.RenewDeadline,
+			RetryPeriod:     config.RetryPeriod,
+			ReleaseOnCancel: true,
+			Name:            config.Name,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(leaderCtx context.Context) {
+					leading.Store(true)
+					fmt.Printf("%s Lease'i %s/%s alındı, kontroller bu instance'ta çalışıyor\n", config.Identity, config.Namespace, config.Name)
+					run(leaderCtx)
+					// leaderCtx iptal edilmeden dönen run işini bitirmiştir;
+					// iptal edildiyse liderlik kaybedilmiştir.
+					if leaderCtx.Err() == nil {
+						finished.Store(true)
+						cancel()
+					}
+				},
+				OnStoppedLeading: func() {
+					if leading.Load() && !finished.Load() {
+						fmt.Printf("%s liderliği kaybetti, yeniden aday olunuyor\n", config.Identity)
+					}
+				},
+				OnNewLeader: func(identity string) {
+					if identity != config.Identity {
+						fmt.Printf("Lider instance: %s, bu instance (%s) bekliyor\n", identity, config.Identity)
+					}
+				},
+			},
+		})
+		if err != nil {
+			cancel()
+			return fmt.Errorf("leader election yapılandırması geçersiz: %w", err)
+		}
+		elector.Run(electionCtx)
+		cancel()
+		if finished.Load() {
+			return nil
+		}
+	}
+}
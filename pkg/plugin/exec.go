@@ -0,0 +1,108 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"go-k8s-client/pkg/checks"
+	"go-k8s-client/pkg/report"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+// ExecCheckConfig, yapılandırma dosyasında tanımlanan tek bir harici komut
+// kontrolüdür. Komut, run cevabıyla aynı JSON şemasını ({"findings": [...]})
+// stdout'a yazmalıdır.
+type ExecCheckConfig struct {
+	Name    string            `json:"name"`
+	Command []string          `json:"command"`
+	Env     map[string]string `json:"env,omitempty"`
+	Timeout string            `json:"timeout,omitempty"`
+}
+
+// ExecChecksFile, harici komut kontrollerini listeleyen YAML ya da JSON dosyasıdır:
+//
+//	execChecks:
+//	  - name: backup-age
+//	    command: ["/opt/checks/backup-age.sh", "--max-age", "24h"]
+//	    timeout: 30s
+type ExecChecksFile struct {
+	ExecChecks []ExecCheckConfig `json:"execChecks"`
+}
+
+// LoadExecChecks, path'teki yapılandırma dosyasından harici komut kontrollerini
+// oluşturur. Komutlar KUBECONFIG ortam değişkeni kubeconfig olarak ayarlanmış
+// şekilde çalıştırılır.
+func LoadExecChecks(path, kubeconfig string) ([]checks.Check, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file ExecChecksFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("%s çözülemedi: %w", path, err)
+	}
+	var list []checks.Check
+	for i, config := range file.ExecChecks {
+		if config.Name == "" || len(config.Command) == 0 {
+			return nil, fmt.Errorf("%s içindeki %d. exec kontrolünde name ve command zorunludur", path, i+1)
+		}
+		timeout := time.Minute
+		if config.Timeout != "" {
+			timeout, err = time.ParseDuration(config.Timeout)
+			if err != nil {
+				return nil, fmt.Errorf("exec kontrolü %s için geçersiz timeout %q: %w", config.Name, config.Timeout, err)
+			}
+		}
+		list = append(list, &execCheck{config: config, timeout: timeout, kubeconfig: kubeconfig})
+	}
+	return list, nil
+}
+
+type execCheck struct {
+	config     ExecCheckConfig
+	timeout    time.Duration
+	kubeconfig string
+}
+
+func (c *execCheck) Name() string {
+	return "exec/" + c.config.Name
+}
+
+func (c *execCheck) Run(ctx context.Context, _ kubernetes.Interface) ([]report.Finding, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, c.config.Command[0], c.config.Command[1:]...)
+	cmd.Env = append(os.Environ(), "KUBECONFIG="+c.kubeconfig)
+	for key, value := range c.config.Env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil && len(output) == 0 {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("exec kontrolü %s başarısız: %w: %s", c.config.Name, err, message)
+		}
+		return nil, fmt.Errorf("exec kontrolü %s başarısız: %w", c.config.Name, err)
+	}
+	// Sıfırdan farklı çıkış kodu, geçerli JSON yazıldığı sürece bulguların
+	// okunmasını engellemez; bazı betikler bulgu olduğunda 1 ile çıkar.
+	var result Result
+	if jsonErr := json.Unmarshal(output, &result); jsonErr != nil {
+		return nil, fmt.Errorf("exec kontrolü %s çıktısı çözülemedi: %w", c.config.Name, jsonErr)
+	}
+	for i := range result.Findings {
+		result.Findings[i].Check = c.Name()
+	}
+	if result.Error != "" {
+		return result.Findings, fmt.Errorf("exec kontrolü %s: %s", c.config.Name, result.Error)
+	}
+	return result.Findings, nil
+}
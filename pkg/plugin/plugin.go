@@ -0,0 +1,239 @@
+// Package plugin, ayrı binary'ler olarak dağıtılan kontrolleri keşfeder ve
+// çalıştırır. Plugin'ler hashicorp/go-plugin üzerinden net/rpc ile konuşur:
+// ana araç plugin binary'sini başlatır, Handshake'teki protokol sürümü ve
+// magic cookie eşleşmezse plugin yüklenmez. Bir plugin Provider arayüzünü
+// uygular ve main fonksiyonunda Serve'ü çağırır:
+//
+//	func main() {
+//		plugin.Serve(myChecks{})
+//	}
+//
+// Plugin'ler cluster'a KUBECONFIG ortam değişkeniyle verilen kubeconfig
+// üzerinden kendileri bağlanır. Run cevabının JSON şeması, yapılandırma
+// dosyasında tanımlanan harici komut kontrolleri (exec kontrolleri) için de kullanılır.
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	goplugin "github.com/hashicorp/go-plugin"
+	"go-k8s-client/pkg/checks"
+	"go-k8s-client/pkg/report"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ProtocolVersion, bu sürümün desteklediği plugin protokolü sürümüdür.
+// Sürüm 1, stdout'a JSON yazan describe/run komut protokolüydü.
+const ProtocolVersion = 2
+
+// Prefix, plugin dizininde plugin olarak kabul edilen dosyaların ad önekidir.
+const Prefix = "k8s-client-plugin-"
+
+// pluginName, go-plugin PluginSet'inde kontrol sağlayıcısının adıdır.
+const pluginName = "checks"
+
+// Handshake, ana araç ile plugin'lerin el sıkışma yapılandırmasıdır. Magic
+// cookie, plugin binary'sinin doğrudan çalıştırılmasını engeller; protokol
+// sürümü uyuşmayan plugin'ler el sıkışmada reddedilir.
+var Handshake = goplugin.HandshakeConfig{
+	ProtocolVersion:  ProtocolVersion,
+	MagicCookieKey:   "K8S_CLIENT_PLUGIN",
+	MagicCookieValue: "c8f1d7a2-checks",
+}
+
+// Description, bir plugin'in sunduğu kontrolleri tanımlar.
+type Description struct {
+	Name   string   `json:"name"`
+	Checks []string `json:"checks"`
+}
+
+// Result, bir plugin ya da exec kontrolünün çalıştırılma sonucudur.
+type Result struct {
+	Findings []report.Finding `json:"findings"`
+	Error    string           `json:"error,omitempty"`
+}
+
+// Provider, plugin binary'lerinin uyguladığı kontrol sağlayıcısı arayüzüdür.
+type Provider interface {
+	Describe() (Description, error)
+	Run(check string) (Result, error)
+}
+
+// Serve, provider'ı go-plugin sunucusu olarak çalıştırır; plugin binary'lerinin
+// main fonksiyonundan çağrılır ve ana araç bağlantıyı kapatana kadar döner.
+func Serve(provider Provider) {
+	goplugin.Serve(&goplugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins:         goplugin.PluginSet{pluginName: &providerPlugin{provider: provider}},
+	})
+}
+
+// providerPlugin, Provider'ı go-plugin'in net/rpc taşımasına bağlar.
+type providerPlugin struct {
+	provider Provider
+}
+
+func (p *providerPlugin) Server(*goplugin.MuxBroker) (interface{}, error) {
+	return &providerServer{provider: p.provider}, nil
+}
+
+func (p *providerPlugin) Client(_ *goplugin.MuxBroker, client *rpc.Client) (interface{}, error) {
+	return &providerClient{client: client}, nil
+}
+
+// providerServer, plugin tarafında RPC çağrılarını Provider'a iletir.
+type providerServer struct {
+	provider Provider
+}
+
+func (s *providerServer) Describe(_ interface{}, reply *Description) error {
+	description, err := s.provider.Describe()
+	*reply = description
+	return err
+}
+
+func (s *providerServer) Run(check string, reply *Result) error {
+	result, err := s.provider.Run(check)
+	*reply = result
+	return err
+}
+
+// providerClient, ana araç tarafında plugin'e RPC çağrıları yapar. Çağrılar
+// context iptal edildiğinde beklenmeden döner.
+type providerClient struct {
+	client *rpc.Client
+}
+
+func (c *providerClient) call(ctx context.Context, method string, args, reply interface{}) error {
+	call := c.client.Go("Plugin."+method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Plugin, keşfedilmiş ve el sıkışmayı geçmiş bir plugin binary'sidir.
+type Plugin struct {
+	Path        string
+	Description Description
+	client      *goplugin.Client
+	provider    *providerClient
+}
+
+// Discover, dir içindeki Prefix ile başlayan çalıştırılabilir dosyaları
+// bulur, her birini başlatıp el sıkışma yapar ve kontrollerini sorar. El
+// sıkışması ya da describe çağrısı başarısız olan plugin'ler hata listesinde
+// döndürülür; diğer plugin'lerin yüklenmesi engellenmez. Dönen plugin'ler
+// işleri bittiğinde Close ile kapatılmalıdır.
+func Discover(ctx context.Context, dir, kubeconfig string) ([]*Plugin, []error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, []error{err}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+	var plugins []*Plugin
+	var errs []error
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), Prefix) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || info.Mode()&0o111 == 0 {
+			continue
+		}
+		plugin, err := start(ctx, filepath.Join(dir, entry.Name()), kubeconfig)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s yüklenemedi: %w", entry.Name(), err))
+			continue
+		}
+		plugins = append(plugins, plugin)
+	}
+	return plugins, errs
+}
+
+// start, path'teki plugin'i başlatır, el sıkışmayı yapar ve describe çağrısıyla
+// kontrollerini alır.
+func start(ctx context.Context, path, kubeconfig string) (*Plugin, error) {
+	cmd := exec.Command(path)
+	cmd.Env = append(os.Environ(), "KUBECONFIG="+kubeconfig)
+	client := goplugin.NewClient(&goplugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          goplugin.PluginSet{pluginName: &providerPlugin{}},
+		Cmd:              cmd,
+		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolNetRPC},
+		Logger:           hclog.New(&hclog.LoggerOptions{Name: "plugin", Level: hclog.Warn, Output: os.Stderr}),
+	})
+	plugin := &Plugin{Path: path, client: client}
+	if err := plugin.connect(ctx); err != nil {
+		client.Kill()
+		return nil, err
+	}
+	if plugin.Description.Name == "" {
+		plugin.Description.Name = strings.TrimPrefix(filepath.Base(path), Prefix)
+	}
+	return plugin, nil
+}
+
+func (p *Plugin) connect(ctx context.Context) error {
+	protocol, err := p.client.Client()
+	if err != nil {
+		return err
+	}
+	raw, err := protocol.Dispense(pluginName)
+	if err != nil {
+		return err
+	}
+	p.provider = raw.(*providerClient)
+	if err := p.provider.call(ctx, "Describe", new(interface{}), &p.Description); err != nil {
+		return fmt.Errorf("describe çağrısı başarısız: %w", err)
+	}
+	return nil
+}
+
+// Close, plugin sürecini sonlandırır.
+func (p *Plugin) Close() {
+	p.client.Kill()
+}
+
+// Checks, plugin'in sunduğu her kontrolü checks.Check olarak döndürür.
+// Kontrol adları "<plugin>/<kontrol>" biçimindedir.
+func (p *Plugin) Checks() []checks.Check {
+	var list []checks.Check
+	for _, name := range p.Description.Checks {
+		list = append(list, &pluginCheck{plugin: p, name: name})
+	}
+	return list
+}
+
+type pluginCheck struct {
+	plugin *Plugin
+	name   string
+}
+
+func (c *pluginCheck) Name() string {
+	return c.plugin.Description.Name + "/" + c.name
+}
+
+func (c *pluginCheck) Run(ctx context.Context, _ kubernetes.Interface) ([]report.Finding, error) {
+	var result Result
+	if err := c.plugin.provider.call(ctx, "Run", c.name, &result); err != nil {
+		return nil, fmt.Errorf("plugin kontrolü %s çalıştırılamadı: %w", c.Name(), err)
+	}
+	for i := range result.Findings {
+		result.Findings[i].Check = c.Name()
+	}
+	if result.Error != "" {
+		return result.Findings, fmt.Errorf("plugin kontrolü %s: %s", c.Name(), result.Error)
+	}
+	return result.Findings, nil
+}
@@ -0,0 +1,52 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	goplugin "github.com/hashicorp/go-plugin"
+	"go-k8s-client/pkg/report"
+)
+
+type testProvider struct{}
+
+func (testProvider) Describe() (Description, error) {
+	return Description{Name: "backup", Checks: []string{"age"}}, nil
+}
+
+func (testProvider) Run(check string) (Result, error) {
+	if check != "age" {
+		return Result{}, errors.New("bilinmeyen kontrol " + check)
+	}
+	return Result{Findings: []report.Finding{{Severity: report.Warning, Reason: "BackupTooOld", Message: "son yedek 30 saat önce alınmış"}}}, nil
+}
+
+func TestPluginRunsChecksOverRPC(t *testing.T) {
+	client, _ := goplugin.TestPluginRPCConn(t, goplugin.PluginSet{pluginName: &providerPlugin{provider: testProvider{}}}, nil)
+	defer client.Close()
+	raw, err := client.Dispense(pluginName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Plugin{provider: raw.(*providerClient)}
+	if err := p.provider.call(context.Background(), "Describe", new(interface{}), &p.Description); err != nil {
+		t.Fatal(err)
+	}
+	list := p.Checks()
+	if len(list) != 1 || list[0].Name() != "backup/age" {
+		t.Fatalf("backup/age kontrolü beklenirken %v döndü", list)
+	}
+	findings, err := list[0].Run(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].Check != "backup/age" || findings[0].Severity != report.Warning {
+		t.Errorf("plugin bulgusu kontrol adıyla dönmeli: %+v", findings)
+	}
+
+	missing := &pluginCheck{plugin: p, name: "missing"}
+	if _, err := missing.Run(context.Background(), nil); err == nil {
+		t.Error("plugin'in döndürdüğü hata kontrole iletilmeli")
+	}
+}
@@ -0,0 +1,419 @@
+// Package remediate, bilinen sorunlar için açıkça izin verilmiş düzeltme
+// eylemlerini uygular. Eylemler varsayılan olarak server-side dry-run ile
+// yalnızca denenir; uygulanan ya da denenen her eylem denetim kaydına yazılır.
+package remediate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"go-k8s-client/pkg/checks"
+	"go-k8s-client/pkg/report"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Action, uygulanabilecek bir düzeltme eyleminin adıdır.
+type Action string
+
+const (
+	// DeleteEvictedPods, kubelet tarafından tahliye edilmiş (Failed/Evicted) pod'ları siler.
+	DeleteEvictedPods Action = "delete-evicted-pods"
+	// RestartCrashLoopingDeployments, pod'ları CrashLoopBackOff durumunda
+	// kalan Deployment'lara kubectl rollout restart ile aynı şekilde restart yapar.
+	RestartCrashLoopingDeployments Action = "restart-crashlooping-deployments"
+	// RetriggerStuckJobs, tamamlanmadan takılı kalan Job'ları aynı spec ile
+	// yeni adla yeniden oluşturup eskisini siler.
+	RetriggerStuckJobs Action = "retrigger-stuck-jobs"
+)
+
+// ParseActions, virgülle ayrılmış eylem adlarını doğrulayarak Action listesine çevirir.
+func ParseActions(value string) ([]Action, error) {
+	var actions []Action
+	for _, name := range strings.Split(value, ",") {
+		action := Action(strings.TrimSpace(name))
+		switch action {
+		case "":
+			continue
+		case DeleteEvictedPods, RestartCrashLoopingDeployments, RetriggerStuckJobs:
+			actions = append(actions, action)
+		default:
+			return nil, fmt.Errorf("bilinmeyen düzeltme eylemi %q; geçerli eylemler: %s, %s, %s", action, DeleteEvictedPods, RestartCrashLoopingDeployments, RetriggerStuckJobs)
+		}
+	}
+	return actions, nil
+}
+
+// restartedAtAnnotation, kubectl rollout restart'ın pod şablonuna yazdığı annotation'dır.
+const restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+// retriggeredFromAnnotation, yeniden tetiklenen Job'un kopyasına yazılır ve
+// kopyalanan Job'un UID'sini tutar; eski Job silinemediyse sonraki
+// çalışmada ikinci bir kopya oluşturulmaz.
+const retriggeredFromAnnotation = "go-k8s-client/retriggered-from"
+
+// Config, hangi eylemlerin nerede ve nasıl uygulanacağını belirler.
+type Config struct {
+	// Actions, izin verilen eylemlerdir; listede olmayan eylem çalışmaz.
+	Actions []Action
+	// Namespaces, eylemlerin uygulanabileceği namespace'lerdir; "*" tüm
+	// namespace'lere izin verir. Boşsa hiçbir eylem uygulanmaz.
+	Namespaces []string
+	// Apply false ise eylemler yalnızca server-side dry-run ile denenir.
+	Apply bool
+	// MaxActions, bir çalışmada uygulanabilecek en fazla eylem sayısıdır.
+	MaxActions int
+	// CrashLoopRestarts, Deployment'a restart yapılması için bir container'ın
+	// ulaşması gereken en az yeniden başlatma sayısıdır.
+	CrashLoopRestarts int32
+	// RestartCooldown, aynı Deployment'a art arda restart yapılmadan önce
+	// beklenecek süredir.
+	RestartCooldown time.Duration
+	// JobStuckAfter, bu süreden uzun süredir tamamlanmayan ve ilerlemeyen
+	// (aktif pod'u olmayan ya da pod'ları başarısız olup hiçbiri hazır
+	// olmayan) Job'ların takılmış sayılacağı süredir.
+	JobStuckAfter time.Duration
+	// Audit, her eylem için bir JSON satırı yazılan denetim kaydıdır.
+	Audit io.Writer
+	// Options, listelemelerde kullanılan sayfalama, yeniden deneme ve
+	// sharding ayarlarıdır.
+	Options checks.Options
+}
+
+// Record, denetim kaydına yazılan tek bir eylemdir.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Action    Action    `json:"action"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       string    `json:"uid,omitempty"`
+	Reason    string    `json:"reason"`
+	DryRun    bool      `json:"dryRun"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Engine, izin verilen düzeltme eylemlerini her çalışmada uygulayan bir
+// kontroldür; bulguları uygulanan ve denenen eylemlerdir.
+type Engine struct {
+	config  Config
+	auditMu sync.Mutex
+	// planned, önceki çalışmada dry-run ile denenen eylemleri "eylem/UID"
+	// anahtarıyla tutar; aynı eylem her döngüde denetim kaydına yeniden
+	// yazılmaz.
+	planned map[string]bool
+}
+
+// New, verilen yapılandırmayla bir Engine oluşturur.
+func New(config Config) *Engine {
+	return &Engine{config: config}
+}
+
+func (e *Engine) Name() string {
+	return "remediation"
+}
+
+// run, tek bir çalışmanın durumunu tutar.
+type run struct {
+	engine   *Engine
+	findings []report.Finding
+	actions  int
+	planned  map[string]bool
+}
+
+func (e *Engine) Run(ctx context.Context, clientset kubernetes.Interface) ([]report.Finding, error) {
+	r := &run{engine: e, planned: map[string]bool{}}
+	// Yalnızca bu çalışmada yeniden denenen eylemler hatırlanır; artık
+	// denenmeyen eylemler unutulur ve saklanan küme büyümez.
+	defer func() { e.planned = r.planned }()
+	for _, action := range e.config.Actions {
+		if r.limitReached() {
+			r.findings = append(r.findings, report.Finding{Check: e.Name(), Severity: report.Warning, Message: fmt.Sprintf("Çalışma başına en fazla %d düzeltme eylemi sınırına ulaşıldı; kalan eylemler bir sonraki çalışmaya bırakıldı", e.config.MaxActions)})
+			break
+		}
+		var err error
+		switch action {
+		case DeleteEvictedPods:
+			err = r.deleteEvictedPods(ctx, clientset)
+		case RestartCrashLoopingDeployments:
+			err = r.restartCrashLoopingDeployments(ctx, clientset)
+		case RetriggerStuckJobs:
+			err = r.retriggerStuckJobs(ctx, clientset)
+		default:
+			err = fmt.Errorf("bilinmeyen düzeltme eylemi %q", action)
+		}
+		if err != nil {
+			return r.findings, err
+		}
+	}
+	return r.findings, nil
+}
+
+// allowed, namespace'in izin listesinde olup olmadığını döndürür.
+func (e *Engine) allowed(namespace string) bool {
+	for _, allowed := range e.config.Namespaces {
+		if allowed == "*" || allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// dryRun, Apply kapalıyken isteklere eklenecek server-side dry-run değeridir.
+func (e *Engine) dryRun() []string {
+	if e.config.Apply {
+		return nil
+	}
+	return []string{metav1.DryRunAll}
+}
+
+// perform, do'yu çalıştırır, sonucu denetim kaydına yazar ve bulgu olarak
+// ekler. Eylem başarılıysa true döner.
+func (r *run) perform(action Action, kind, namespace, name string, uid types.UID, reason string, do func() error) bool {
+	r.actions++
+	record := Record{Time: time.Now().UTC(), Action: action, Kind: kind, Namespace: namespace, Name: name, UID: string(uid), Reason: reason, DryRun: !r.engine.config.Apply}
+	err := do()
+	if err != nil {
+		record.Error = err.Error()
+	}
+	// Dry-run'da hiçbir şey değişmediğinden aynı nesne için aynı eylem her
+	// döngüde yeniden planlanır; denetim kaydına yalnızca ilk kez yazılır.
+	plannedKey := string(action) + "/" + string(uid)
+	if !record.DryRun || !r.engine.planned[plannedKey] {
+		r.engine.audit(record)
+	}
+	if record.DryRun {
+		r.planned[plannedKey] = true
+	}
+
+	mode := "uygulandı"
+	if record.DryRun {
+		mode = "dry-run ile denendi"
+	}
+	finding := report.Finding{Check: r.engine.Name(), Severity: report.Info, Message: fmt.Sprintf("%s: %s %s/%s için %s (%s)", action, kind, namespace, name, mode, reason)}
+	if err != nil {
+		finding.Severity = report.Warning
+		finding.Message = fmt.Sprintf("%s: %s %s/%s için eylem başarısız oldu: %v", action, kind, namespace, name, err)
+	}
+	r.findings = append(r.findings, finding)
+	return err == nil
+}
+
+// audit, kaydı denetim kaydına JSON satırı olarak yazar.
+func (e *Engine) audit(record Record) {
+	if e.config.Audit == nil {
+		return
+	}
+	data, _ := json.Marshal(record)
+	e.auditMu.Lock()
+	defer e.auditMu.Unlock()
+	e.config.Audit.Write(append(data, '\n'))
+}
+
+// deleteEvictedPods, izin verilen namespace'lerdeki tahliye edilmiş pod'ları siler.
+func (r *run) deleteEvictedPods(ctx context.Context, clientset kubernetes.Interface) error {
+	pods, err := checks.ListAll(ctx, r.engine.config.Options, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Failed"})
+	if err != nil {
+		return fmt.Errorf("Tahliye edilmiş pod'ları listelerken hata oluştu: %w", err)
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Reason != "Evicted" || !r.engine.allowed(pod.Namespace) {
+			continue
+		}
+		pod := pod
+		r.perform(DeleteEvictedPods, "Pod", pod.Namespace, pod.Name, pod.UID, "pod tahliye edilmiş: "+pod.Status.Message, func() error {
+			return clientset.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{DryRun: r.engine.dryRun()})
+		})
+		if r.limitReached() {
+			return nil
+		}
+	}
+	return nil
+}
+
+// restartCrashLoopingDeployments, CrashLoopBackOff durumundaki pod'ların
+// ait olduğu Deployment'lara pod şablonundaki restartedAt annotation'ını
+// güncelleyerek restart yapar. Aynı Deployment, annotation'daki son restart
+// zamanından itibaren --remediate-restart-cooldown geçmeden yeniden restart
+// edilmez; bekleme süresi annotation'dan okunduğundan yeniden başlatmalar ve
+// lider değişimleri arasında da korunur.
+func (r *run) restartCrashLoopingDeployments(ctx context.Context, clientset kubernetes.Interface) error {
+	pods, err := checks.ListAll(ctx, r.engine.config.Options, clientset.CoreV1().Pods("").List, metav1.ListOptions{FieldSelector: "status.phase=Running"})
+	if err != nil {
+		return fmt.Errorf("CrashLoopBackOff pod'larını listelerken hata oluştu: %w", err)
+	}
+	seen := map[string]bool{}
+	for _, pod := range pods.Items {
+		if !r.engine.allowed(pod.Namespace) || !crashLooping(&pod, r.engine.config.CrashLoopRestarts) {
+			continue
+		}
+		owner := metav1.GetControllerOf(&pod)
+		if owner == nil || owner.Kind != "ReplicaSet" {
+			continue
+		}
+		replicaSet, err := clientset.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			continue
+		}
+		deployment := metav1.GetControllerOf(replicaSet)
+		if deployment == nil || deployment.Kind != "Deployment" {
+			continue
+		}
+		key := pod.Namespace + "/" + deployment.Name
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		current, err := clientset.AppsV1().Deployments(pod.Namespace).Get(ctx, deployment.Name, metav1.GetOptions{})
+		if err != nil {
+			continue
+		}
+		if last, err := time.Parse(time.RFC3339, current.Spec.Template.Annotations[restartedAtAnnotation]); err == nil && time.Since(last) < r.engine.config.RestartCooldown {
+			continue
+		}
+		now := time.Now().UTC().Format(time.RFC3339)
+		patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`, restartedAtAnnotation, now)
+		name := deployment.Name
+		r.perform(RestartCrashLoopingDeployments, "Deployment", pod.Namespace, name, current.UID, fmt.Sprintf("pod %s CrashLoopBackOff durumunda", pod.Name), func() error {
+			_, err := clientset.AppsV1().Deployments(pod.Namespace).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{DryRun: r.engine.dryRun()})
+			return err
+		})
+		if r.limitReached() {
+			return nil
+		}
+	}
+	return nil
+}
+
+// crashLooping, pod'un en az restarts kez yeniden başlamış ve
+// CrashLoopBackOff durumunda bekleyen bir container'ı olup olmadığını döndürür.
+func crashLooping(pod *corev1.Pod, restarts int32) bool {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" && status.RestartCount >= restarts {
+			return true
+		}
+	}
+	return false
+}
+
+// retriggerStuckJobs, --remediate-job-stuck-after süresinden uzun süredir
+// tamamlanmamış ve ilerlemeyen Job'ların önce aynı spec ile yeni adlı bir kopyasını
+// oluşturur, ancak bu başarılı olursa eski Job'u UID ön koşuluyla siler.
+// Kopya GenerateName ile oluşturulduğundan eski Job'un adı yeniden
+// kullanılmaz ve silinmesinin tamamlanması beklenmez. Eski Job için daha
+// önce bir kopya oluşturulmuşsa yalnızca silme yeniden denenir.
+func (r *run) retriggerStuckJobs(ctx context.Context, clientset kubernetes.Interface) error {
+	jobs, err := checks.ListAll(ctx, r.engine.config.Options, clientset.BatchV1().Jobs("").List, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Job'ları listelerken hata oluştu: %w", err)
+	}
+	copied := map[string]bool{}
+	for _, job := range jobs.Items {
+		if from := job.Annotations[retriggeredFromAnnotation]; from != "" {
+			copied[from] = true
+		}
+	}
+	for _, job := range jobs.Items {
+		if !r.engine.allowed(job.Namespace) || job.DeletionTimestamp != nil || !stuck(&job, r.engine.config.JobStuckAfter) {
+			continue
+		}
+		job := job
+		r.perform(RetriggerStuckJobs, "Job", job.Namespace, job.Name, job.UID, fmt.Sprintf("Job %s süredir tamamlanmadı", time.Since(job.Status.StartTime.Time).Round(time.Minute)), func() error {
+			if !copied[string(job.UID)] {
+				if _, err := clientset.BatchV1().Jobs(job.Namespace).Create(ctx, retriggered(&job), metav1.CreateOptions{DryRun: r.engine.dryRun()}); err != nil {
+					return fmt.Errorf("Job kopyası oluşturulurken hata oluştu: %w", err)
+				}
+			}
+			background := metav1.DeletePropagationBackground
+			uid := job.UID
+			if err := clientset.BatchV1().Jobs(job.Namespace).Delete(ctx, job.Name, metav1.DeleteOptions{PropagationPolicy: &background, Preconditions: &metav1.Preconditions{UID: &uid}, DryRun: r.engine.dryRun()}); err != nil {
+				return fmt.Errorf("kopya oluşturuldu ancak eski Job silinirken hata oluştu: %w", err)
+			}
+			return nil
+		})
+		if r.limitReached() {
+			return nil
+		}
+	}
+	return nil
+}
+
+// stuck, Job'un başlamış, tamamlanmamış ya da başarısız olmamış, after
+// süresinden uzun süredir çalışan ve ilerlemeyen bir Job olup olmadığını
+// döndürür. Uzun süren ama sağlıklı çalışan Job'lara dokunulmaması için
+// yalnızca süre yetmez: Job'un aktif pod'u yoksa ya da pod'ları başarısız
+// olmuş ve hiçbiri hazır değilse (ör. backoff'ta bekliyorsa) takılmış sayılır.
+func stuck(job *batchv1.Job, after time.Duration) bool {
+	if job.Status.StartTime == nil || job.Status.CompletionTime != nil || time.Since(job.Status.StartTime.Time) < after {
+		return false
+	}
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == corev1.ConditionTrue {
+			return false
+		}
+	}
+	if job.Status.Active == 0 {
+		return true
+	}
+	ready := int32(0)
+	if job.Status.Ready != nil {
+		ready = *job.Status.Ready
+	}
+	return job.Status.Failed > 0 && ready == 0
+}
+
+// retriggered, Job'un controller tarafından eklenen selector ve label'lardan
+// arındırılmış, yeni adla oluşturulacak bir kopyasını döndürür. Controller
+// OwnerReference'ı (ör. CronJob) kopyalanmaz; aksi halde CronJob kopyayı
+// kendi oluşturmadığı fazladan bir aktif Job olarak sayar.
+func retriggered(job *batchv1.Job) *batchv1.Job {
+	prefix := job.Name
+	if len(prefix) > 50 {
+		prefix = prefix[:50]
+	}
+	annotations := map[string]string{}
+	for key, value := range job.Annotations {
+		annotations[key] = value
+	}
+	annotations[retriggeredFromAnnotation] = string(job.UID)
+	labels := map[string]string{}
+	for key, value := range job.Labels {
+		labels[key] = value
+	}
+	var owners []metav1.OwnerReference
+	for _, owner := range job.OwnerReferences {
+		if owner.Controller == nil || !*owner.Controller {
+			owners = append(owners, owner)
+		}
+	}
+	retry := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    prefix + "-retry-",
+			Namespace:       job.Namespace,
+			Labels:          labels,
+			Annotations:     annotations,
+			OwnerReferences: owners,
+		},
+		Spec: *job.Spec.DeepCopy(),
+	}
+	retry.Spec.Selector = nil
+	retry.Spec.ManualSelector = nil
+	for _, label := range []string{"controller-uid", "job-name", batchv1.ControllerUidLabel, batchv1.JobNameLabel} {
+		delete(retry.Labels, label)
+		delete(retry.Spec.Template.Labels, label)
+	}
+	return retry
+}
+
+// limitReached, bu çalışmada eylem sınırına ulaşılıp ulaşılmadığını döndürür.
+func (r *run) limitReached() bool {
+	return r.engine.config.MaxActions > 0 && r.actions >= r.engine.config.MaxActions
+}
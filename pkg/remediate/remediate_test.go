@@ -0,0 +1,181 @@
+package remediate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go-k8s-client/pkg/checks"
+	"go-k8s-client/pkg/report"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func evictedPod(namespace, name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Status:     corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted", Message: "The node was low on resource: memory."},
+	}
+}
+
+func TestDryRunDeleteEvictedPods(t *testing.T) {
+	clientset := fake.NewSimpleClientset(evictedPod("apps", "web"), evictedPod("kube-system", "dns"))
+	var audit bytes.Buffer
+	engine := New(Config{
+		Actions:    []Action{DeleteEvictedPods},
+		Namespaces: []string{"apps"},
+		Audit:      &audit,
+		Options:    checks.DefaultOptions(),
+	})
+
+	findings, err := engine.Run(context.Background(), clientset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].Severity != report.Info {
+		t.Fatalf("izin verilen namespace'teki pod için tek bir Info bulgusu beklenirdi: %+v", findings)
+	}
+
+	var deletes []k8stesting.DeleteActionImpl
+	for _, action := range clientset.Actions() {
+		if deleteAction, ok := action.(k8stesting.DeleteActionImpl); ok {
+			deletes = append(deletes, deleteAction)
+		}
+	}
+	if len(deletes) != 1 || deletes[0].Namespace != "apps" || deletes[0].Name != "web" {
+		t.Fatalf("yalnızca apps/web silinmeliydi: %+v", deletes)
+	}
+	if dryRun := deletes[0].DeleteOptions.DryRun; len(dryRun) != 1 || dryRun[0] != metav1.DryRunAll {
+		t.Errorf("--remediate-apply verilmeden silme dry-run ile yapılmalı, DryRun: %v", dryRun)
+	}
+
+	var record Record
+	if err := json.Unmarshal(audit.Bytes(), &record); err != nil {
+		t.Fatalf("denetim kaydı çözülemedi: %v", err)
+	}
+	if !record.DryRun || record.Action != DeleteEvictedPods || record.Name != "web" {
+		t.Errorf("denetim kaydı dry-run eylemini içermeli: %+v", record)
+	}
+}
+
+func TestDryRunRetriggerStuckJobCreatesBeforeDeleting(t *testing.T) {
+	started := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "migrate", UID: "job-uid"},
+		Status:     batchv1.JobStatus{StartTime: &started, Failed: 3},
+	}
+	clientset := fake.NewSimpleClientset(job)
+	engine := New(Config{
+		Actions:       []Action{RetriggerStuckJobs},
+		Namespaces:    []string{"*"},
+		JobStuckAfter: time.Hour,
+		Options:       checks.DefaultOptions(),
+	})
+
+	if _, err := engine.Run(context.Background(), clientset); err != nil {
+		t.Fatal(err)
+	}
+	var verbs []string
+	for _, action := range clientset.Actions() {
+		switch action := action.(type) {
+		case k8stesting.CreateActionImpl:
+			verbs = append(verbs, "create")
+			created := action.GetObject().(*batchv1.Job)
+			if created.Annotations[retriggeredFromAnnotation] != "job-uid" {
+				t.Errorf("kopya eski Job'un UID'sini taşımalı: %v", created.Annotations)
+			}
+		case k8stesting.DeleteActionImpl:
+			verbs = append(verbs, "delete")
+			if preconditions := action.DeleteOptions.Preconditions; preconditions == nil || preconditions.UID == nil || *preconditions.UID != "job-uid" {
+				t.Errorf("eski Job UID ön koşuluyla silinmeli: %+v", preconditions)
+			}
+			if len(action.DeleteOptions.DryRun) == 0 {
+				t.Error("silme dry-run ile yapılmalı")
+			}
+		}
+	}
+	if len(verbs) != 2 || verbs[0] != "create" || verbs[1] != "delete" {
+		t.Errorf("önce kopya oluşturulup sonra eski Job silinmeli, yapılan: %v", verbs)
+	}
+}
+
+func TestRetriggerStuckJobsLeavesRunningJobs(t *testing.T) {
+	started := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	ready := int32(1)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "apps", Name: "backfill", UID: "job-uid"},
+		Status:     batchv1.JobStatus{StartTime: &started, Active: 1, Ready: &ready},
+	}
+	clientset := fake.NewSimpleClientset(job)
+	engine := New(Config{
+		Actions:       []Action{RetriggerStuckJobs},
+		Namespaces:    []string{"*"},
+		JobStuckAfter: time.Hour,
+		Options:       checks.DefaultOptions(),
+	})
+
+	findings, err := engine.Run(context.Background(), clientset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 0 {
+		t.Errorf("aktif pod'ları çalışan Job'a dokunulmamalı: %+v", findings)
+	}
+	for _, action := range clientset.Actions() {
+		if action.GetVerb() == "create" || action.GetVerb() == "delete" {
+			t.Errorf("çalışan Job için %s yapılmamalı", action.GetVerb())
+		}
+	}
+}
+
+func TestRetriggeredDropsControllerLabelsAndOwner(t *testing.T) {
+	controller := true
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "apps",
+			Name:      "report-28000000",
+			UID:       "job-uid",
+			Labels:    map[string]string{"app": "report", "controller-uid": "job-uid", batchv1.JobNameLabel: "report-28000000"},
+			OwnerReferences: []metav1.OwnerReference{
+				{Kind: "CronJob", Name: "report", UID: "cronjob-uid", Controller: &controller},
+				{Kind: "ConfigMap", Name: "settings", UID: "configmap-uid"},
+			},
+		},
+	}
+	retry := retriggered(job)
+	if len(retry.Labels) != 1 || retry.Labels["app"] != "report" {
+		t.Errorf("controller label'ları kopyadan silinmeli: %v", retry.Labels)
+	}
+	if len(job.Labels) != 3 {
+		t.Errorf("eski Job'un label'ları değişmemeli: %v", job.Labels)
+	}
+	if len(retry.OwnerReferences) != 1 || retry.OwnerReferences[0].Kind != "ConfigMap" {
+		t.Errorf("controller OwnerReference kopyalanmamalı: %+v", retry.OwnerReferences)
+	}
+}
+
+func TestDryRunAuditsPlannedActionOnce(t *testing.T) {
+	pod := evictedPod("apps", "web")
+	pod.UID = "pod-uid"
+	clientset := fake.NewSimpleClientset(pod)
+	var audit bytes.Buffer
+	engine := New(Config{
+		Actions:    []Action{DeleteEvictedPods},
+		Namespaces: []string{"apps"},
+		Audit:      &audit,
+		Options:    checks.DefaultOptions(),
+	})
+	for i := 0; i < 3; i++ {
+		if _, err := engine.Run(context.Background(), clientset); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if lines := bytes.Count(audit.Bytes(), []byte("\n")); lines != 1 {
+		t.Errorf("aynı dry-run eylemi denetim kaydına bir kez yazılmalı, %d satır yazıldı", lines)
+	}
+}
@@ -0,0 +1,107 @@
+// Package report, kontrollerin ürettiği bulguları ve bunların çıktıya
+// yazılmasını tanımlar.
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Severity, bir bulgunun önem derecesidir.
+type Severity int
+
+const (
+	Info Severity = iota
+	Warning
+	Critical
+)
+
+// ParseSeverity, yapılandırmada kullanılan "critical" ve "warning"
+// değerlerini Severity'ye çevirir; diğer değerler Info kabul edilir.
+func ParseSeverity(severity string) Severity {
+	switch strings.ToLower(severity) {
+	case "critical":
+		return Critical
+	case "warning":
+		return Warning
+	}
+	return Info
+}
+
+func (s Severity) String() string {
+	switch s {
+	case Critical:
+		return "critical"
+	case Warning:
+		return "warning"
+	}
+	return "info"
+}
+
+// MarshalText, Severity'yi JSON ve benzeri biçimlerde adıyla yazar.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText, "critical", "warning" ve "info" adlarını Severity'ye çevirir.
+func (s *Severity) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "critical", "warning", "info", "":
+		*s = ParseSeverity(string(text))
+		return nil
+	}
+	return fmt.Errorf("bilinmeyen önem derecesi %q", text)
+}
+
+// Prefix, bulgu mesajının önüne yazılan önem derecesi önekini döndürür.
+func (s Severity) Prefix() string {
+	switch s {
+	case Critical:
+		return "KRİTİK: "
+	case Warning:
+		return "UYARI: "
+	}
+	return ""
+}
+
+// ResourceRef, bir bulgunun ilgili olduğu Kubernetes nesnesini belirtir.
+// Cluster geneli nesnelerde Namespace boştur.
+type ResourceRef struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+func (r ResourceRef) String() string {
+	if r.Namespace == "" {
+		return r.Kind + "/" + r.Name
+	}
+	return r.Kind + "/" + r.Namespace + "/" + r.Name
+}
+
+// Finding, bir kontrolün raporladığı tek bir bulgudur. Resource ve Reason
+// bulgunun hangi nesneyle ve hangi nedenle ilgili olduğunu makine
+// tarafından okunabilir şekilde taşır; bilinmediklerinde boş kalırlar.
+// Reason sabit bir koddur; nesne adı, port veya yüzde gibi değişken
+// bilgiler Resource ya da Message içinde yer alır.
+type Finding struct {
+	Check    string       `json:"check"`
+	Severity Severity     `json:"severity"`
+	Resource *ResourceRef `json:"resource,omitempty"`
+	Reason   string       `json:"reason,omitempty"`
+	Message  string       `json:"message"`
+}
+
+func (f Finding) String() string {
+	// Alt maddeler olarak girintilenmiş mesajlarda önek girintiden sonra yazılır.
+	message := strings.TrimLeft(f.Message, " ")
+	return f.Message[:len(f.Message)-len(message)] + f.Severity.Prefix() + message
+}
+
+// Print, bulguları her satıra bir bulgu gelecek şekilde w'ye yazar.
+func Print(w io.Writer, findings []Finding) {
+	for _, finding := range findings {
+		fmt.Fprintln(w, finding.String())
+	}
+}
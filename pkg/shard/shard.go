@@ -0,0 +1,172 @@
+// Package shard, namespace'leri --shards replica'ya bölerek çalışan
+// instance'ların döngü sonuçlarını ConfigMap'ler üzerinden paylaşmasını ve
+// liderin bunları tek bir rapor halinde birleştirmesini sağlar.
+package shard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// configMapKey, sonuçların ConfigMap'te saklandığı anahtardır.
+const configMapKey = "results.json"
+
+// Result, bir kontrolün bir replica'daki çalışmasının sonucudur.
+type Result struct {
+	Check    string           `json:"check"`
+	Findings []report.Finding `json:"findings,omitempty"`
+	Error    string           `json:"error,omitempty"`
+}
+
+// Report, bir replica'nın tek bir döngüde ürettiği sonuçlardır.
+type Report struct {
+	Shard   int       `json:"shard"`
+	Shards  int       `json:"shards"`
+	Time    time.Time `json:"time"`
+	Results []Result  `json:"results"`
+}
+
+// Exchange, replica'ların raporlarını Namespace içindeki
+// "<Prefix>-shard-<sıra>" adlı ConfigMap'lerde saklar. ConfigMap boyutu
+// 1 MiB ile sınırlı olduğundan bir replica'nın bulguları bu sınırı aşmamalıdır;
+// aşıyorsa --shards artırılmalıdır.
+type Exchange struct {
+	Clientset kubernetes.Interface
+	Namespace string
+	Prefix    string
+	Shards    int
+}
+
+func (e Exchange) name(index int) string {
+	return fmt.Sprintf("%s-shard-%d", e.Prefix, index)
+}
+
+// Publish, raporu replica'nın ConfigMap'ine yazar.
+func (e Exchange) Publish(ctx context.Context, rep Report) error {
+	data, err := json.Marshal(rep)
+	if err != nil {
+		return err
+	}
+	name := e.name(rep.Shard)
+	configMaps := e.Clientset.CoreV1().ConfigMaps(e.Namespace)
+	configMap, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: e.Namespace},
+			Data:       map[string]string{configMapKey: string(data)},
+		}
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[configMapKey] = string(data)
+	_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	return err
+}
+
+// Collect, except dışındaki replica'ların maxAge'den yeni raporlarını okur.
+// Raporu olmayan, eskimiş ya da farklı bir --shards değeriyle yazılmış
+// replica'lar missing olarak döner; bu replica'ların namespace'leri bu
+// döngüde raporlanamaz.
+func (e Exchange) Collect(ctx context.Context, except int, maxAge time.Duration) (reports []Report, missing []int, err error) {
+	var errs []error
+	for index := 0; index < e.Shards; index++ {
+		if index == except {
+			continue
+		}
+		configMap, err := e.Clientset.CoreV1().ConfigMaps(e.Namespace).Get(ctx, e.name(index), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			missing = append(missing, index)
+			continue
+		} else if err != nil {
+			missing = append(missing, index)
+			errs = append(errs, fmt.Errorf("ConfigMap %s/%s alınırken hata oluştu: %w", e.Namespace, e.name(index), err))
+			continue
+		}
+		var rep Report
+		if err := json.Unmarshal([]byte(configMap.Data[configMapKey]), &rep); err != nil {
+			missing = append(missing, index)
+			errs = append(errs, fmt.Errorf("ConfigMap %s/%s çözülemedi: %w", e.Namespace, e.name(index), err))
+			continue
+		}
+		if rep.Shards != e.Shards || time.Since(rep.Time) > maxAge {
+			missing = append(missing, index)
+			continue
+		}
+		reports = append(reports, rep)
+	}
+	return reports, missing, errors.Join(errs...)
+}
+
+// Merge, raporlardaki sonuçları kontrol adına göre birleştirir. Sonuçlar
+// ilk raporda görüldükleri sırayla döner. Namespace'lere göre bölünen
+// kontrollerin aynı cluster geneli nesne hakkındaki bulguları (ör. bir
+// IngressClass) her replica'da aynı üretildiğinden teke indirilir; kontrol
+// bazı replica'larda hata verdiyse hatalar replica sırasıyla birleştirilir.
+func Merge(reports []Report) []Result {
+	var order []string
+	merged := map[string]*Result{}
+	seen := map[string]map[string]bool{}
+	for _, rep := range reports {
+		for _, result := range rep.Results {
+			target, ok := merged[result.Check]
+			if !ok {
+				target = &Result{Check: result.Check}
+				merged[result.Check] = target
+				seen[result.Check] = map[string]bool{}
+				order = append(order, result.Check)
+			}
+			for _, finding := range result.Findings {
+				key := findingKey(finding)
+				if seen[result.Check][key] {
+					continue
+				}
+				seen[result.Check][key] = true
+				target.Findings = append(target.Findings, finding)
+			}
+			if result.Error != "" {
+				message := "shard " + strconv.Itoa(rep.Shard) + ": " + result.Error
+				target.Error = strings.TrimPrefix(target.Error+"\n"+message, "\n")
+			}
+		}
+	}
+	results := make([]Result, 0, len(order))
+	for _, check := range order {
+		results = append(results, *merged[check])
+	}
+	return results
+}
+
+func findingKey(finding report.Finding) string {
+	key := finding.Severity.String() + "\x00" + finding.Reason + "\x00" + finding.Message
+	if finding.Resource != nil {
+		key += "\x00" + finding.Resource.String()
+	}
+	return key
+}
+
+// OrdinalFromHostname, StatefulSet pod adlarındaki ("go-k8s-client-2")
+// sondaki sıra numarasını döndürür.
+func OrdinalFromHostname(hostname string) (int, error) {
+	i := strings.LastIndex(hostname, "-")
+	ordinal, err := strconv.Atoi(hostname[i+1:])
+	if i < 0 || err != nil {
+		return 0, fmt.Errorf("%q adından shard sırası çıkarılamadı; --shard-index verilmelidir", hostname)
+	}
+	return ordinal, nil
+}
@@ -0,0 +1,52 @@
+package shard
+
+import (
+	"testing"
+
+	"go-k8s-client/pkg/report"
+)
+
+func TestMerge(t *testing.T) {
+	ingressClass := &report.ResourceRef{Kind: "IngressClass", Name: "nginx"}
+	reports := []Report{
+		{Shard: 0, Results: []Result{
+			{Check: "ingresses", Findings: []report.Finding{
+				{Check: "ingresses", Severity: report.Warning, Resource: ingressClass, Reason: "MissingController", Message: "IngressClass nginx için controller yok"},
+				{Check: "ingresses", Severity: report.Warning, Message: "Ingress a/web için backend yok"},
+			}},
+			{Check: "tls-secrets"},
+		}},
+		{Shard: 1, Results: []Result{
+			{Check: "ingresses", Findings: []report.Finding{
+				{Check: "ingresses", Severity: report.Warning, Resource: ingressClass, Reason: "MissingController", Message: "IngressClass nginx için controller yok"},
+				{Check: "ingresses", Severity: report.Warning, Message: "Ingress b/web için backend yok"},
+			}},
+			{Check: "tls-secrets", Error: "Secret'ları listelerken hata oluştu"},
+		}},
+	}
+
+	results := Merge(reports)
+	if len(results) != 2 || results[0].Check != "ingresses" || results[1].Check != "tls-secrets" {
+		t.Fatalf("sonuçlar ilk rapordaki sırayla dönmeli, dönen: %+v", results)
+	}
+	if got := len(results[0].Findings); got != 3 {
+		t.Errorf("aynı nesne hakkındaki bulgu teke indirilmeli; 3 bulgu beklenirken %d döndü", got)
+	}
+	if want := "shard 1: Secret'ları listelerken hata oluştu"; results[1].Error != want {
+		t.Errorf("hata %q olmalı, dönen %q", want, results[1].Error)
+	}
+}
+
+func TestOrdinalFromHostname(t *testing.T) {
+	for hostname, want := range map[string]int{"go-k8s-client-0": 0, "go-k8s-client-12": 12} {
+		got, err := OrdinalFromHostname(hostname)
+		if err != nil || got != want {
+			t.Errorf("OrdinalFromHostname(%q) = %d, %v; %d beklenirdi", hostname, got, err, want)
+		}
+	}
+	for _, hostname := range []string{"go-k8s-client", "worker-abc", ""} {
+		if _, err := OrdinalFromHostname(hostname); err == nil {
+			t.Errorf("OrdinalFromHostname(%q) hata döndürmeliydi", hostname)
+		}
+	}
+}
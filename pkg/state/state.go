@@ -0,0 +1,154 @@
+// Package state, bulguların yaşam döngüsünü (ilk ve son görülme, onaylanma,
+// çözülme) döngüler ve yeniden başlatmalar arasında saklar. Böylece araç
+// yeniden başlatıldığında mevcut bulgular yeni gibi raporlanmaz ve bir
+// sorunun ne kadar süredir devam ettiği kaybolmaz.
+package state
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"go-k8s-client/pkg/report"
+)
+
+// Entry, tek bir bulgunun saklanan durumudur.
+type Entry struct {
+	Check     string              `json:"check"`
+	Severity  report.Severity     `json:"severity"`
+	Resource  *report.ResourceRef `json:"resource,omitempty"`
+	Reason    string              `json:"reason,omitempty"`
+	Message   string              `json:"message"`
+	FirstSeen time.Time           `json:"firstSeen"`
+	LastSeen  time.Time           `json:"lastSeen"`
+	// Acknowledged, bulgunun bilindiğini belirtmek için saklanan dosyada ya
+	// da ConfigMap'te elle true yapılır; onaylanan bulgular raporlanmaz.
+	Acknowledged bool       `json:"acknowledged,omitempty"`
+	ResolvedAt   *time.Time `json:"resolvedAt,omitempty"`
+}
+
+// Store, bulgu durumlarının kalıcı olarak saklandığı yerdir.
+type Store interface {
+	Load(ctx context.Context) (map[string]*Entry, error)
+	Save(ctx context.Context, entries map[string]*Entry) error
+}
+
+// Observed, bir çalışmadaki bulgunun saklanan durumuyla birlikte hali.
+type Observed struct {
+	report.Finding
+	Entry *Entry
+	// New, bulgunun ilk kez görüldüğünü ya da çözüldükten sonra yeniden
+	// ortaya çıktığını belirtir.
+	New bool
+}
+
+// Tracker, kontrollerin bulgularını saklanan durumlarla eşleştirir.
+type Tracker struct {
+	store     Store
+	retention time.Duration
+	entries   map[string]*Entry
+}
+
+// NewTracker, store'daki durumu yükleyerek bir Tracker oluşturur. Çözülen
+// bulgular retention süresi dolduktan sonra silinir.
+func NewTracker(ctx context.Context, store Store, retention time.Duration) (*Tracker, error) {
+	entries, err := store.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = map[string]*Entry{}
+	}
+	return &Tracker{store: store, retention: retention, entries: entries}, nil
+}
+
+// Reload, bellekteki durumu atarak store'daki durumu yeniden yükler. Lider
+// seçimi açıkken liderliği sonradan alan bir instance, önceki liderin
+// kaydettiği durumun üzerine başlangıçta yüklediği eski durumu yazmamak için
+// döngülere başlamadan önce Reload çağırmalıdır.
+func (t *Tracker) Reload(ctx context.Context) error {
+	entries, err := t.store.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if entries == nil {
+		entries = map[string]*Entry{}
+	}
+	t.entries = entries
+	return nil
+}
+
+// volatile, bulgu mesajlarında döngüden döngüye değişen Go sürelerini
+// ("45s", "1m0s", "2h3m4.5s") birimleriyle birlikte tek parça olarak yakalar;
+// böylece süre bir birimden diğerine geçtiğinde de kimlik değişmez. Birimsiz
+// sayılar nesne adlarının (ör. "worker-1", "web-0") parçası olabileceğinden
+// eşleşmez.
+var volatile = regexp.MustCompile(`\b([0-9]+(\.[0-9]+)?(ns|us|µs|ms|h|m|s))+\b`)
+
+// key, bulgunun kimliğidir. Nesnesi ve nedeni bilinen bulgularda kimlik
+// bunlardan oluşur; diğerlerinde mesaj kullanılır, ancak mesajlardaki
+// süreler her döngüde değiştiğinden (ör. "5m0s süredir") kimliğe dahil
+// edilmez.
+func key(finding report.Finding) string {
+	if finding.Resource != nil && finding.Reason != "" {
+		return finding.Check + "\x00" + finding.Resource.String() + "\x00" + finding.Reason
+	}
+	return finding.Check + "\x00" + volatile.ReplaceAllString(finding.Message, "#")
+}
+
+// Observe, check'in başarıyla tamamlanan bir çalışmasının bulgularını
+// kaydeder ve her bulguyu durumuyla birlikte döndürür. Bu kontrolün daha
+// önce açık olup bu çalışmada görülmeyen bulguları çözülmüş olarak
+// işaretlenip resolved olarak döner. Hata veren kontroller için Observe
+// çağrılmamalıdır; aksi halde açık bulguları yanlışlıkla çözülmüş sayılır.
+func (t *Tracker) Observe(check string, findings []report.Finding, now time.Time) (observed []Observed, resolved []*Entry) {
+	seen := map[string]bool{}
+	for _, finding := range findings {
+		k := key(finding)
+		seen[k] = true
+		entry, ok := t.entries[k]
+		isNew := !ok || entry.ResolvedAt != nil
+		if !ok {
+			entry = &Entry{Check: finding.Check, FirstSeen: now}
+			t.entries[k] = entry
+		} else if entry.ResolvedAt != nil {
+			entry.FirstSeen = now
+			entry.ResolvedAt = nil
+			entry.Acknowledged = false
+		}
+		entry.Severity = finding.Severity
+		entry.Resource = finding.Resource
+		entry.Reason = finding.Reason
+		entry.Message = finding.Message
+		entry.LastSeen = now
+		observed = append(observed, Observed{Finding: finding, Entry: entry, New: isNew})
+	}
+	for k, entry := range t.entries {
+		if entry.Check != check || seen[k] {
+			continue
+		}
+		if entry.ResolvedAt == nil {
+			resolvedAt := now
+			entry.ResolvedAt = &resolvedAt
+			resolved = append(resolved, entry)
+		} else if now.Sub(*entry.ResolvedAt) > t.retention {
+			delete(t.entries, k)
+		}
+	}
+	return observed, resolved
+}
+
+// Save, güncel durumu store'a yazar. Araç çalışırken store'da elle
+// onaylanan bulgular yazmadan önce okunup korunur.
+func (t *Tracker) Save(ctx context.Context) error {
+	stored, err := t.store.Load(ctx)
+	if err != nil {
+		return err
+	}
+	for k, entry := range stored {
+		if current, ok := t.entries[k]; ok && entry.Acknowledged && entry.ResolvedAt == nil && current.FirstSeen.Equal(entry.FirstSeen) {
+			current.Acknowledged = true
+		}
+	}
+	return t.store.Save(ctx, t.entries)
+}
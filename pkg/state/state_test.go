@@ -0,0 +1,111 @@
+package state
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-k8s-client/pkg/report"
+)
+
+type memoryStore map[string]*Entry
+
+func (m memoryStore) Load(context.Context) (map[string]*Entry, error) {
+	entries := map[string]*Entry{}
+	for k, entry := range m {
+		copied := *entry
+		entries[k] = &copied
+	}
+	return entries, nil
+}
+
+func (m memoryStore) Save(context.Context, map[string]*Entry) error {
+	return nil
+}
+
+func TestKeyIgnoresDurations(t *testing.T) {
+	messages := []string{
+		"Pod a/web 45s süredir Pending",
+		"Pod a/web 1m0s süredir Pending",
+		"Pod a/web 2h3m4.5s süredir Pending",
+		"Pod a/web 500ms süredir Pending",
+	}
+	want := key(report.Finding{Check: "pods", Message: messages[0]})
+	for _, message := range messages[1:] {
+		if got := key(report.Finding{Check: "pods", Message: message}); got != want {
+			t.Errorf("%q için kimlik %q, %q beklenirdi", message, got, want)
+		}
+	}
+	if key(report.Finding{Check: "pods", Message: "Pod a/web 45s süredir Pending"}) == key(report.Finding{Check: "pods", Message: "Pod a/api 45s süredir Pending"}) {
+		t.Error("farklı nesnelerin bulguları aynı kimliği almamalı")
+	}
+}
+
+func TestKeyKeepsNumbersInNames(t *testing.T) {
+	pairs := [][2]string{
+		{"Node worker-1 45s süredir NotReady", "Node worker-2 45s süredir NotReady"},
+		{"Pod a/web-0 yeniden başlatılıyor", "Pod a/web-1 yeniden başlatılıyor"},
+		{"Node ip-10-0-1-23 disk dolu", "Node ip-10-0-1-24 disk dolu"},
+	}
+	for _, pair := range pairs {
+		if key(report.Finding{Check: "nodes", Message: pair[0]}) == key(report.Finding{Check: "nodes", Message: pair[1]}) {
+			t.Errorf("%q ve %q aynı kimliği almamalı", pair[0], pair[1])
+		}
+	}
+}
+
+func TestKeyUsesResourceAndReason(t *testing.T) {
+	ref := &report.ResourceRef{Kind: "Node", Name: "worker-1"}
+	first := report.Finding{Check: "nodes", Resource: ref, Reason: "NotReady", Message: "Node worker-1 3 dakikadır hazır değil"}
+	second := report.Finding{Check: "nodes", Resource: ref, Reason: "NotReady", Message: "Node worker-1 artık farklı bir mesajla hazır değil"}
+	if key(first) != key(second) {
+		t.Error("nesnesi ve nedeni bilinen bulguların kimliği mesajdan bağımsız olmalı")
+	}
+}
+
+func TestTrackerObserve(t *testing.T) {
+	tracker, err := NewTracker(context.Background(), memoryStore{}, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	finding := report.Finding{Check: "pods", Severity: report.Warning, Message: "Pod a/web 45s süredir Pending"}
+
+	observed, _ := tracker.Observe("pods", []report.Finding{finding}, start)
+	if len(observed) != 1 || !observed[0].New {
+		t.Fatalf("ilk görülen bulgu yeni olmalı: %+v", observed)
+	}
+
+	finding.Message = "Pod a/web 1m45s süredir Pending"
+	observed, resolved := tracker.Observe("pods", []report.Finding{finding}, start.Add(time.Minute))
+	if len(observed) != 1 || observed[0].New || len(resolved) != 0 {
+		t.Fatalf("süresi değişen bulgu aynı bulgu sayılmalı: %+v, çözülen: %+v", observed, resolved)
+	}
+	if !observed[0].Entry.FirstSeen.Equal(start) {
+		t.Errorf("FirstSeen %s olmalı, dönen %s", start, observed[0].Entry.FirstSeen)
+	}
+
+	if _, resolved = tracker.Observe("pods", nil, start.Add(2*time.Minute)); len(resolved) != 1 {
+		t.Errorf("görülmeyen bulgu çözülmüş olarak dönmeli, dönen %d", len(resolved))
+	}
+}
+
+func TestTrackerReload(t *testing.T) {
+	store := memoryStore{}
+	tracker, err := NewTracker(context.Background(), store, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	finding := report.Finding{Check: "pods", Severity: report.Warning, Message: "Pod a/web Pending"}
+	// Başka bir lider bu sırada bulguyu kaydetmiş olsun.
+	store[key(finding)] = &Entry{Check: "pods", Message: finding.Message, FirstSeen: start}
+
+	if err := tracker.Reload(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	observed, _ := tracker.Observe("pods", []report.Finding{finding}, start.Add(time.Hour))
+	if len(observed) != 1 || observed[0].New || !observed[0].Entry.FirstSeen.Equal(start) {
+		t.Errorf("yeniden yüklenen durumdaki bulgu yeni sayılmamalı: %+v", observed)
+	}
+}
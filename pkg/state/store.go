@@ -0,0 +1,109 @@
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// FileStore, durumu yerel bir JSON dosyasında saklar.
+type FileStore struct {
+	Path string
+}
+
+func (s FileStore) Load(ctx context.Context) (map[string]*Entry, error) {
+	data, err := os.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var entries map[string]*Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("%s çözülemedi: %w", s.Path, err)
+	}
+	return entries, nil
+}
+
+// Save, dosyayı yarıda kesilen yazmalarda bozulmaması için önce geçici bir
+// dosyaya yazar ve ardından yerine taşır.
+func (s FileStore) Save(ctx context.Context, entries map[string]*Entry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return err
+	}
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), s.Path)
+}
+
+// configMapKey, durumun ConfigMap'te saklandığı anahtardır.
+const configMapKey = "findings.json"
+
+// ConfigMapStore, durumu bir ConfigMap'te saklar; böylece cluster içinde
+// çalışan aracın durumu pod yeniden oluşturulduğunda da korunur. ConfigMap
+// boyutu 1 MiB ile sınırlı olduğundan çok sayıda bulgu üreten cluster'larda
+// FileStore ve kalıcı bir volume tercih edilmelidir.
+type ConfigMapStore struct {
+	Clientset kubernetes.Interface
+	Namespace string
+	Name      string
+}
+
+func (s ConfigMapStore) Load(ctx context.Context) (map[string]*Entry, error) {
+	configMap, err := s.Clientset.CoreV1().ConfigMaps(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var entries map[string]*Entry
+	if data := configMap.Data[configMapKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &entries); err != nil {
+			return nil, fmt.Errorf("ConfigMap %s/%s çözülemedi: %w", s.Namespace, s.Name, err)
+		}
+	}
+	return entries, nil
+}
+
+func (s ConfigMapStore) Save(ctx context.Context, entries map[string]*Entry) error {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	configMaps := s.Clientset.CoreV1().ConfigMaps(s.Namespace)
+	configMap, err := configMaps.Get(ctx, s.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: s.Name, Namespace: s.Namespace},
+			Data:       map[string]string{configMapKey: string(data)},
+		}
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[configMapKey] = string(data)
+	_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	return err
+}
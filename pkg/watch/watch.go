@@ -0,0 +1,106 @@
+// Package watch, pod faz geçişlerini ve yeni Warning event'lerini periyodik
+// kontrolleri beklemeden, gerçekleştikleri anda raporlar.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-k8s-client/pkg/report"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Run, pod ve Warning event informer'larını başlatır ve her değişikliği
+// emit'e bir bulgu olarak iletir. Başlangıçta cache'e dolan mevcut nesneler
+// raporlanmaz. ctx iptal edilene kadar bloklar.
+func Run(ctx context.Context, clientset kubernetes.Interface, resync time.Duration, emit func(report.Finding)) error {
+	podFactory := informers.NewSharedInformerFactory(clientset, resync)
+	podInformer := podFactory.Core().V1().Pods().Informer()
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldPod, newPod := oldObj.(*corev1.Pod), newObj.(*corev1.Pod)
+			if oldPod.Status.Phase == newPod.Status.Phase {
+				return
+			}
+			severity := report.Info
+			if newPod.Status.Phase == corev1.PodFailed || newPod.Status.Phase == corev1.PodUnknown {
+				severity = report.Warning
+			}
+			emit(report.Finding{Check: "watch/pods", Severity: severity, Message: fmt.Sprintf("Pod %s namespace %s %s -> %s", newPod.Name, newPod.Namespace, oldPod.Status.Phase, newPod.Status.Phase)})
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if pod, ok := obj.(*corev1.Pod); ok {
+				emit(report.Finding{Check: "watch/pods", Severity: report.Info, Message: fmt.Sprintf("Pod %s namespace %s silindi", pod.Name, pod.Namespace)})
+			}
+		},
+	})
+
+	eventFactory := informers.NewSharedInformerFactoryWithOptions(clientset, resync, informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+		options.FieldSelector = "type=" + corev1.EventTypeWarning
+	}))
+	eventInformer := eventFactory.Core().V1().Events().Informer()
+	started := time.Now()
+	reportEvent := func(event *corev1.Event) {
+		emit(report.Finding{Check: "watch/events", Severity: report.Warning, Message: fmt.Sprintf("Event %s %s/%s: %s (%d kez)", event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message, eventCount(event))})
+	}
+	eventInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			// Başlangıçtaki liste ile gelen eski event'ler raporlanmaz.
+			if event := obj.(*corev1.Event); lastSeen(event).After(started) {
+				reportEvent(event)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldEvent, newEvent := oldObj.(*corev1.Event), newObj.(*corev1.Event)
+			// Tekrarlanan event'ler aynı nesnenin sayacını ya da events.k8s.io
+			// üzerinden yazılanlarda Series sayacını artırır.
+			if eventCount(newEvent) > eventCount(oldEvent) || lastSeen(newEvent).After(lastSeen(oldEvent)) {
+				reportEvent(newEvent)
+			}
+		},
+	})
+
+	podFactory.Start(ctx.Done())
+	eventFactory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), podInformer.HasSynced, eventInformer.HasSynced) {
+		return fmt.Errorf("watch informer cache'leri dolmadan durduruldu: %w", ctx.Err())
+	}
+	<-ctx.Done()
+	return nil
+}
+
+// eventCount, event'in kaç kez gözlendiğini döndürür. events.k8s.io
+// üzerinden yazılan event'lerde tekrarlar Count yerine Series.Count'ta tutulur.
+func eventCount(event *corev1.Event) int32 {
+	if event.Series != nil && event.Series.Count > event.Count {
+		return event.Series.Count
+	}
+	return event.Count
+}
+
+// lastSeen, event'in en son gözlendiği zamanı döndürür. events.k8s.io
+// üzerinden yazılan event'lerde LastTimestamp boş kalabilir; tekrarlanan
+// event'lerin son gözlemi Series.LastObservedTime'dadır.
+func lastSeen(event *corev1.Event) time.Time {
+	var latest time.Time
+	for _, t := range []time.Time{event.LastTimestamp.Time, event.EventTime.Time} {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	if event.Series != nil && event.Series.LastObservedTime.Time.After(latest) {
+		latest = event.Series.LastObservedTime.Time
+	}
+	if latest.IsZero() {
+		return event.CreationTimestamp.Time
+	}
+	return latest
+}
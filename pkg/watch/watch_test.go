@@ -0,0 +1,33 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEventSeriesCountsAndLastSeen(t *testing.T) {
+	created := time.Now().Add(-time.Hour)
+	observed := time.Now().Add(-time.Minute)
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		EventTime:  metav1.NewMicroTime(created),
+		Series:     &corev1.EventSeries{Count: 7, LastObservedTime: metav1.NewMicroTime(observed)},
+	}
+	if got := eventCount(event); got != 7 {
+		t.Errorf("Series.Count kullanılmalı: 7 beklenirken %d", got)
+	}
+	if got := lastSeen(event); !got.Equal(observed) {
+		t.Errorf("Series.LastObservedTime kullanılmalı: %s beklenirken %s", observed, got)
+	}
+
+	legacy := &corev1.Event{Count: 3, LastTimestamp: metav1.NewTime(observed)}
+	if got := eventCount(legacy); got != 3 {
+		t.Errorf("Series yokken Count kullanılmalı: 3 beklenirken %d", got)
+	}
+	if got := lastSeen(legacy); !got.Equal(observed) {
+		t.Errorf("LastTimestamp kullanılmalı: %s beklenirken %s", observed, got)
+	}
+}